@@ -2,13 +2,17 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/goccy/go-json"
 )
 
+var ErrAccessDeviceEnrollmentAppNotFound = errors.New("no device enrollment application found")
+
 // AccessApplicationType represents the application type.
 type AccessApplicationType string
 
@@ -202,11 +206,17 @@ type UpdateAccessApplicationParams struct {
 
 // ListAccessApplications returns all applications within an account or zone.
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API reference: https://developers.cloudflare.com/api/operations/access-applications-list-access-applications
 // Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-access-applications-list-access-applications
 func (api *API) ListAccessApplications(ctx context.Context, rc *ResourceContainer, params ListAccessApplicationsParams) ([]AccessApplication, *ResultInfo, error) {
 	baseURL := fmt.Sprintf("/%s/%s/access/apps", rc.Level, rc.Identifier)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessApplication{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
@@ -344,13 +354,17 @@ func (api *API) DeleteAccessApplication(ctx context.Context, rc *ResourceContain
 }
 
 // RevokeAccessApplicationTokens revokes tokens associated with an
-// access application.
+// access application, forcing every user to authenticate again.
 //
 // Account API reference: https://developers.cloudflare.com/api/operations/access-applications-revoke-service-tokens
 // Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-access-applications-revoke-service-tokens
 func (api *API) RevokeAccessApplicationTokens(ctx context.Context, rc *ResourceContainer, applicationID string) error {
+	if applicationID == "" {
+		return ErrMissingApplicationID
+	}
+
 	uri := fmt.Sprintf(
-		"/%s/%s/access/apps/%s/revoke-tokens",
+		"/%s/%s/access/apps/%s/revoke_tokens",
 		rc.Level,
 		rc.Identifier,
 		applicationID,
@@ -363,3 +377,144 @@ func (api *API) RevokeAccessApplicationTokens(ctx context.Context, rc *ResourceC
 
 	return nil
 }
+
+// AccessApplicationWithPolicies is an Access application together with the
+// policies attached to it.
+type AccessApplicationWithPolicies struct {
+	Application AccessApplication
+	Policies    []AccessPolicy
+
+	// ServiceTokenIDs are the IDs of the service tokens referenced by the
+	// include and require rules of the policies.
+	ServiceTokenIDs []string
+}
+
+// GetAccessApplicationWithPolicies returns a single application along with
+// all of its policies and the service tokens they allow.
+func (api *API) GetAccessApplicationWithPolicies(ctx context.Context, rc *ResourceContainer, applicationID string) (AccessApplicationWithPolicies, error) {
+	if applicationID == "" {
+		return AccessApplicationWithPolicies{}, ErrMissingApplicationID
+	}
+
+	app, err := api.GetAccessApplication(ctx, rc, applicationID)
+	if err != nil {
+		return AccessApplicationWithPolicies{}, err
+	}
+
+	policies, _, err := api.ListAccessPolicies(ctx, rc, ListAccessPoliciesParams{ApplicationID: applicationID})
+	if err != nil {
+		return AccessApplicationWithPolicies{}, err
+	}
+
+	var tokenIDs []string
+	for _, policy := range policies {
+		for _, rules := range [][]interface{}{policy.Include, policy.Require} {
+			for _, id := range accessRuleServiceTokenIDs(rules) {
+				if !contains(tokenIDs, id) {
+					tokenIDs = append(tokenIDs, id)
+				}
+			}
+		}
+	}
+
+	return AccessApplicationWithPolicies{
+		Application:     app,
+		Policies:        policies,
+		ServiceTokenIDs: tokenIDs,
+	}, nil
+}
+
+// accessRuleServiceTokenIDs returns the IDs of the service tokens referenced
+// by the `service_token` rules in rules.
+func accessRuleServiceTokenIDs(rules []interface{}) []string {
+	var ids []string
+	for _, rule := range rules {
+		b, err := json.Marshal(rule)
+		if err != nil {
+			continue
+		}
+
+		var r AccessGroupServiceToken
+		if err := json.Unmarshal(b, &r); err != nil {
+			continue
+		}
+
+		if r.ServiceToken.ID != "" {
+			ids = append(ids, r.ServiceToken.ID)
+		}
+	}
+
+	return ids
+}
+
+// CreateAccessDeviceEnrollmentApp creates the application of type `warp`
+// whose policies decide who can enroll devices into the Zero Trust
+// organization of an account. An account can only have one. The domain
+// defaults to the `/warp` path of the organization's auth domain and the name
+// to "Warp Login App".
+//
+// API reference: https://developers.cloudflare.com/cloudflare-one/connections/connect-devices/warp/deployment/device-enrollment/
+func (api *API) CreateAccessDeviceEnrollmentApp(ctx context.Context, rc *ResourceContainer, params CreateAccessApplicationParams) (AccessApplication, error) {
+	if rc.Level != AccountRouteLevel {
+		return AccessApplication{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AccessApplication{}, ErrMissingAccountID
+	}
+
+	if params.Domain == "" {
+		org, _, err := api.GetAccessOrganization(ctx, rc, GetAccessOrganizationParams{})
+		if err != nil {
+			return AccessApplication{}, err
+		}
+
+		if org.AuthDomain == "" {
+			return AccessApplication{}, ErrMissingAccessAuthDomain
+		}
+
+		domain := strings.TrimPrefix(strings.TrimPrefix(org.AuthDomain, "https://"), "http://")
+		params.Domain = strings.TrimSuffix(domain, "/") + "/warp"
+	}
+
+	if params.Name == "" {
+		params.Name = "Warp Login App"
+	}
+
+	params.Type = Warp
+
+	return api.CreateAccessApplication(ctx, rc, params)
+}
+
+// GetDeviceEnrollmentPolicies returns the policies of the device enrollment
+// application of an account, which decide who can enroll devices.
+// ErrAccessDeviceEnrollmentAppNotFound is returned when the account has no
+// such application.
+func (api *API) GetDeviceEnrollmentPolicies(ctx context.Context, rc *ResourceContainer) ([]AccessPolicy, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccessPolicy{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AccessPolicy{}, ErrMissingAccountID
+	}
+
+	apps, _, err := api.ListAccessApplications(ctx, rc, ListAccessApplicationsParams{})
+	if err != nil {
+		return []AccessPolicy{}, err
+	}
+
+	for _, app := range apps {
+		if app.Type != Warp {
+			continue
+		}
+
+		policies, _, err := api.ListAccessPolicies(ctx, rc, ListAccessPoliciesParams{ApplicationID: app.ID})
+		if err != nil {
+			return []AccessPolicy{}, err
+		}
+		return policies, nil
+	}
+
+	return []AccessPolicy{}, ErrAccessDeviceEnrollmentAppNotFound
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -448,15 +449,83 @@ func TestRevokeAccessApplicationTokens(t *testing.T) {
     `)
 	}
 
-	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/revoke-tokens", handler)
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/revoke_tokens", handler)
 	err := client.RevokeAccessApplicationTokens(context.Background(), AccountIdentifier(testAccountID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1db")
 
 	assert.NoError(t, err)
 
-	mux.HandleFunc("/zones/"+testZoneID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/revoke-tokens", handler)
+	mux.HandleFunc("/zones/"+testZoneID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/revoke_tokens", handler)
 	err = client.RevokeAccessApplicationTokens(context.Background(), ZoneIdentifier(testZoneID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1db")
 
 	assert.NoError(t, err)
+
+	err = client.RevokeAccessApplicationTokens(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingApplicationID)
+}
+
+func TestGetAccessApplicationWithPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+        "name": "Admin Site",
+        "domain": "test.example.com/admin",
+        "type": "self_hosted"
+      }
+    }`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": [
+        {
+          "id": "699d98642c564d2e855e9661899b7252",
+          "name": "Allow CI",
+          "decision": "non_identity",
+          "include": [
+            {"service_token": {"token_id": "aa0a4aab-672b-4bdb-bc33-a59f1130a11f"}},
+            {"email": {"email": "ops@example.com"}}
+          ],
+          "require": [
+            {"service_token": {"token_id": "bb0a4aab-672b-4bdb-bc33-a59f1130a11f"}}
+          ]
+        },
+        {
+          "id": "799d98642c564d2e855e9661899b7252",
+          "name": "Allow CI again",
+          "decision": "non_identity",
+          "include": [
+            {"service_token": {"token_id": "aa0a4aab-672b-4bdb-bc33-a59f1130a11f"}}
+          ]
+        }
+      ],
+      "result_info": {"page": 1, "per_page": 25, "count": 2, "total_count": 2}
+    }`)
+	})
+
+	_, err := client.GetAccessApplicationWithPolicies(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingApplicationID)
+
+	actual, err := client.GetAccessApplicationWithPolicies(context.Background(), AccountIdentifier(testAccountID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1db")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Admin Site", actual.Application.Name)
+		if assert.Len(t, actual.Policies, 2) {
+			assert.Equal(t, "699d98642c564d2e855e9661899b7252", actual.Policies[0].ID)
+		}
+		assert.Equal(t, []string{"aa0a4aab-672b-4bdb-bc33-a59f1130a11f", "bb0a4aab-672b-4bdb-bc33-a59f1130a11f"}, actual.ServiceTokenIDs)
+	}
 }
 
 func TestAccessApplicationWithCORS(t *testing.T) {
@@ -873,3 +942,96 @@ func TestCreateApplicationWithAccessAppLauncherCustomization(t *testing.T) {
 		assert.Equal(t, fullAccessApplication, actual)
 	}
 }
+
+func TestCreateAccessDeviceEnrollmentApp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/organizations", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"name": "Widget Corps", "auth_domain": "widgetcorps.cloudflareaccess.com"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"name": "Warp Login App",
+			"domain": "widgetcorps.cloudflareaccess.com/warp",
+			"type": "warp",
+			"allowed_idps": ["f174e90a-fafe-4643-bbbc-4a0ed4fc8415"],
+			"session_duration": "720h",
+			"private_address": "",
+			"self_hosted_domains": null,
+			"landing_page_design": {"title": "", "message": "", "image_url": "", "button_color": "", "button_text_color": ""},
+			"app_launcher_logo_url": "",
+			"header_bg_color": "",
+			"bg_color": "",
+			"footer_links": null
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+        "name": "Warp Login App",
+        "domain": "widgetcorps.cloudflareaccess.com/warp",
+        "type": "warp",
+        "allowed_idps": ["f174e90a-fafe-4643-bbbc-4a0ed4fc8415"],
+        "session_duration": "720h"
+      }
+    }`)
+	})
+
+	_, err := client.CreateAccessDeviceEnrollmentApp(context.Background(), ZoneIdentifier(testZoneID), CreateAccessApplicationParams{})
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	actual, err := client.CreateAccessDeviceEnrollmentApp(context.Background(), AccountIdentifier(testAccountID), CreateAccessApplicationParams{
+		AllowedIdps:     []string{"f174e90a-fafe-4643-bbbc-4a0ed4fc8415"},
+		SessionDuration: "720h",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Warp, actual.Type)
+		assert.Equal(t, "widgetcorps.cloudflareaccess.com/warp", actual.Domain)
+	}
+}
+
+func TestGetDeviceEnrollmentPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	apps := `[{"id": "580f4f69-1a28-4fdd-9240-1ed29f0ac1db", "name": "Launcher", "domain": "widgetcorps.cloudflareaccess.com", "type": "app_launcher"}]`
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 25, "count": 1, "total_count": 1}}`, apps)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": [
+        {"id": "699d98642c564d2e855e9661899b7252", "name": "Employees", "decision": "allow", "include": [{"email_domain": {"domain": "example.com"}}]}
+      ],
+      "result_info": {"page": 1, "per_page": 25, "count": 1, "total_count": 1}
+    }`)
+	})
+
+	_, err := client.GetDeviceEnrollmentPolicies(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrAccessDeviceEnrollmentAppNotFound)
+
+	apps = `[
+		{"id": "580f4f69-1a28-4fdd-9240-1ed29f0ac1db", "name": "Launcher", "domain": "widgetcorps.cloudflareaccess.com", "type": "app_launcher"},
+		{"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", "name": "Warp Login App", "domain": "widgetcorps.cloudflareaccess.com/warp", "type": "warp"}
+	]`
+	policies, err := client.GetDeviceEnrollmentPolicies(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) && assert.Len(t, policies, 1) {
+		assert.Equal(t, "Employees", policies[0].Name)
+	}
+}
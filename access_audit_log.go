@@ -20,6 +20,7 @@ type AccessAuditLogRecord struct {
 	Action     string     `json:"action"`
 	Connection string     `json:"connection"`
 	Allowed    bool       `json:"allowed"`
+	Country    string     `json:"country,omitempty"`
 	CreatedAt  *time.Time `json:"created_at"`
 	RayID      string     `json:"ray_id"`
 }
@@ -45,6 +46,10 @@ type AccessAuditLogFilterOptions struct {
 //
 // API reference: https://api.cloudflare.com/#access-requests-access-requests-audit
 func (api *API) AccessAuditLogs(ctx context.Context, accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, error) {
+	if accountID == "" {
+		return []AccessAuditLogRecord{}, ErrMissingAccountID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/access/logs/access-requests?%s", accountID, opts.Encode())
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
@@ -61,6 +66,74 @@ func (api *API) AccessAuditLogs(ctx context.Context, accountID string, opts Acce
 	return accessAuditLogListResponse.Result, nil
 }
 
+// AccessAuthenticationLog is a login to or logout from an Access application.
+type AccessAuthenticationLog struct {
+	UserEmail string `json:"user_email"`
+	IPAddress string `json:"ip_address"`
+	AppUID    string `json:"app_uid"`
+	AppDomain string `json:"app_domain"`
+	// Action is `login` or `logout`.
+	Action     string     `json:"action"`
+	Connection string     `json:"connection"`
+	Allowed    bool       `json:"allowed"`
+	Country    string     `json:"country"`
+	Created    *time.Time `json:"created_at"`
+	RayID      string     `json:"ray_id"`
+}
+
+// AccessAuthenticationLogsResponse represents the response from the Access
+// authentication logs endpoint.
+type AccessAuthenticationLogsResponse struct {
+	Result []AccessAuthenticationLog `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// AccessAuthLogsOptions filters the events returned by
+// AccessAuthenticationLogs.
+type AccessAuthLogsOptions struct {
+	Since *time.Time
+	Until *time.Time
+	Limit int
+	// Direction is `asc` or `desc`.
+	Direction string
+}
+
+// AccessAuthenticationLogs returns the authentication events of the Access
+// applications in the account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/access-authentication-logs-get-access-authentication-logs
+func (api *API) AccessAuthenticationLogs(ctx context.Context, rc *ResourceContainer, opts AccessAuthLogsOptions) ([]AccessAuthenticationLog, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccessAuthenticationLog{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AccessAuthenticationLog{}, ErrMissingAccountID
+	}
+
+	filter := AccessAuditLogFilterOptions{
+		Direction: opts.Direction,
+		Since:     opts.Since,
+		Until:     opts.Until,
+		Limit:     opts.Limit,
+	}
+	uri := fmt.Sprintf("/%s/%s/access/logs/access_requests?%s", rc.Level, rc.Identifier, filter.Encode())
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AccessAuthenticationLog{}, err
+	}
+
+	var r AccessAuthenticationLogsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AccessAuthenticationLog{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
 // Encode is a custom method for encoding the filter options into a usable HTTP
 // query parameter string.
 func (a AccessAuditLogFilterOptions) Encode() string {
@@ -30,6 +30,7 @@ func TestAccessAuditLogs(t *testing.T) {
       "action": "login",
       "connection": "saml",
       "allowed": false,
+      "country": "us",
       "created_at": "2014-01-01T05:20:00.12345Z",
       "ray_id": "187d944c61940c77"
     }
@@ -49,10 +50,14 @@ func TestAccessAuditLogs(t *testing.T) {
 		Action:     "login",
 		Connection: "saml",
 		Allowed:    false,
+		Country:    "us",
 		CreatedAt:  &createdAt,
 		RayID:      "187d944c61940c77",
 	}}
 
+	_, err := client.AccessAuditLogs(context.Background(), "", AccessAuditLogFilterOptions{})
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
 	actual, err := client.AccessAuditLogs(context.Background(), "01a7362d577a6c3019a474fd6f485823", AccessAuditLogFilterOptions{})
 
 	if assert.NoError(t, err) {
@@ -60,6 +65,67 @@ func TestAccessAuditLogs(t *testing.T) {
 	}
 }
 
+func TestAccessAuthenticationLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/logs/access_requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "direction=asc&limit=50&since=2020-07-01T00%3A00%3A00Z&until=2020-07-02T00%3A00%3A00Z", r.URL.RawQuery)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"user_email": "michelle@example.com",
+					"ip_address": "198.51.100.1",
+					"app_uid": "df7e2w5f-02b7-4d9d-af26-8d1988fca630",
+					"app_domain": "test.example.com/admin",
+					"action": "logout",
+					"connection": "saml",
+					"allowed": true,
+					"country": "us",
+					"created_at": "2020-07-01T05:20:00Z",
+					"ray_id": "187d944c61940c77"
+				}
+			]
+		}`)
+	})
+
+	since := time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+	created := since.Add(5*time.Hour + 20*time.Minute)
+
+	logs, err := client.AccessAuthenticationLogs(context.Background(), AccountIdentifier(testAccountID), AccessAuthLogsOptions{
+		Since:     &since,
+		Until:     &until,
+		Limit:     50,
+		Direction: "asc",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AccessAuthenticationLog{{
+			UserEmail:  "michelle@example.com",
+			IPAddress:  "198.51.100.1",
+			AppUID:     "df7e2w5f-02b7-4d9d-af26-8d1988fca630",
+			AppDomain:  "test.example.com/admin",
+			Action:     "logout",
+			Connection: "saml",
+			Allowed:    true,
+			Country:    "us",
+			Created:    &created,
+			RayID:      "187d944c61940c77",
+		}}, logs)
+	}
+
+	_, err = client.AccessAuthenticationLogs(context.Background(), ZoneIdentifier(testZoneID), AccessAuthLogsOptions{})
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	_, err = client.AccessAuthenticationLogs(context.Background(), AccountIdentifier(""), AccessAuthLogsOptions{})
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+}
+
 func TestAccessAuditLogsEncodeAllParametersDefined(t *testing.T) {
 	since, _ := time.Parse(time.RFC3339, "2020-07-01T00:00:00Z")
 	until, _ := time.Parse(time.RFC3339, "2020-07-02T00:00:00Z")
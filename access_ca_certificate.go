@@ -41,11 +41,17 @@ type CreateAccessCACertificateParams struct {
 
 // ListAccessCACertificates returns all AccessCACertificate within Access.
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API reference: https://developers.cloudflare.com/api/operations/access-short-lived-certificate-c-as-list-short-lived-certificate-c-as
 // Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-access-short-lived-certificate-c-as-list-short-lived-certificate-c-as
 func (api *API) ListAccessCACertificates(ctx context.Context, rc *ResourceContainer, params ListAccessCACertificatesParams) ([]AccessCACertificate, *ResultInfo, error) {
 	baseURL := fmt.Sprintf("/%s/%s/access/apps/ca", rc.Level, rc.Identifier)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessCACertificate{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
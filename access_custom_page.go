@@ -54,6 +54,10 @@ type UpdateAccessCustomPageParams struct {
 }
 
 func (api *API) ListAccessCustomPages(ctx context.Context, rc *ResourceContainer, params ListAccessCustomPagesParams) ([]AccessCustomPage, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccessCustomPage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	uri := buildURI(fmt.Sprintf("/%s/%s/access/custom_pages", rc.Level, rc.Identifier), params)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -63,12 +67,20 @@ func (api *API) ListAccessCustomPages(ctx context.Context, rc *ResourceContainer
 	var customPagesResponse AccessCustomPageListResponse
 	err = json.Unmarshal(res, &customPagesResponse)
 	if err != nil {
-		return []AccessCustomPage{}, err
+		return []AccessCustomPage{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return customPagesResponse.Result, nil
 }
 
 func (api *API) GetAccessCustomPage(ctx context.Context, rc *ResourceContainer, id string) (AccessCustomPage, error) {
+	if rc.Level != AccountRouteLevel {
+		return AccessCustomPage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if id == "" {
+		return AccessCustomPage{}, ErrMissingUID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/custom_pages/%s", rc.Level, rc.Identifier, id)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -78,12 +90,16 @@ func (api *API) GetAccessCustomPage(ctx context.Context, rc *ResourceContainer,
 	var customPageResponse AccessCustomPageResponse
 	err = json.Unmarshal(res, &customPageResponse)
 	if err != nil {
-		return AccessCustomPage{}, err
+		return AccessCustomPage{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return customPageResponse.Result, nil
 }
 
 func (api *API) CreateAccessCustomPage(ctx context.Context, rc *ResourceContainer, params CreateAccessCustomPageParams) (AccessCustomPage, error) {
+	if rc.Level != AccountRouteLevel {
+		return AccessCustomPage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/custom_pages", rc.Level, rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -93,12 +109,20 @@ func (api *API) CreateAccessCustomPage(ctx context.Context, rc *ResourceContaine
 	var customPageResponse AccessCustomPageResponse
 	err = json.Unmarshal(res, &customPageResponse)
 	if err != nil {
-		return AccessCustomPage{}, err
+		return AccessCustomPage{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return customPageResponse.Result, nil
 }
 
 func (api *API) DeleteAccessCustomPage(ctx context.Context, rc *ResourceContainer, id string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if id == "" {
+		return ErrMissingUID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/custom_pages/%s", rc.Level, rc.Identifier, id)
 	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -108,6 +132,10 @@ func (api *API) DeleteAccessCustomPage(ctx context.Context, rc *ResourceContaine
 }
 
 func (api *API) UpdateAccessCustomPage(ctx context.Context, rc *ResourceContainer, params UpdateAccessCustomPageParams) (AccessCustomPage, error) {
+	if rc.Level != AccountRouteLevel {
+		return AccessCustomPage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if params.UID == "" {
 		return AccessCustomPage{}, ErrMissingUID
 	}
@@ -121,7 +149,37 @@ func (api *API) UpdateAccessCustomPage(ctx context.Context, rc *ResourceContaine
 	var customPageResponse AccessCustomPageResponse
 	err = json.Unmarshal(res, &customPageResponse)
 	if err != nil {
-		return AccessCustomPage{}, err
+		return AccessCustomPage{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return customPageResponse.Result, nil
 }
+
+// ListAccessCustomPageApplications returns the Access applications of an
+// account that use the custom page, which stop showing it when the page is
+// deleted. AccessCustomPage.AppCount holds the number of applications.
+func (api *API) ListAccessCustomPageApplications(ctx context.Context, rc *ResourceContainer, id string) ([]AccessApplication, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccessApplication{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if id == "" {
+		return []AccessApplication{}, ErrMissingUID
+	}
+
+	apps, _, err := api.ListAccessApplications(ctx, rc, ListAccessApplicationsParams{})
+	if err != nil {
+		return []AccessApplication{}, err
+	}
+
+	referencing := []AccessApplication{}
+	for _, app := range apps {
+		for _, page := range app.CustomPages {
+			if page == id {
+				referencing = append(referencing, app)
+				break
+			}
+		}
+	}
+
+	return referencing, nil
+}
@@ -194,3 +194,31 @@ func TestDeleteAccessCustomPage(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestListAccessCustomPageApplications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", "name": "Admin", "custom_pages": ["480f4f69-1a28-4fdd-9240-1ed29f0ac1dc"]},
+				{"id": "5c6d7a0e-8d2b-4d3c-9a5e-2f1e3b4c5d6e", "name": "Wiki"}
+			],
+			"result_info": {"page": 1, "per_page": 25, "count": 2, "total_count": 2, "total_pages": 1}
+		}`)
+	})
+
+	_, err := client.ListAccessCustomPageApplications(context.Background(), ZoneIdentifier(testZoneID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1dc")
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	apps, err := client.ListAccessCustomPageApplications(context.Background(), AccountIdentifier(testAccountID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1dc")
+	if assert.NoError(t, err) && assert.Len(t, apps, 1) {
+		assert.Equal(t, "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", apps[0].ID)
+	}
+}
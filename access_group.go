@@ -252,11 +252,17 @@ type UpdateAccessGroupParams struct {
 
 // ListAccessGroups returns all access groups for an access application.
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API Reference: https://developers.cloudflare.com/api/operations/access-groups-list-access-groups
 // Zone API Reference: https://developers.cloudflare.com/api/operations/zone-level-access-groups-list-access-groups
 func (api *API) ListAccessGroups(ctx context.Context, rc *ResourceContainer, params ListAccessGroupsParams) ([]AccessGroup, *ResultInfo, error) {
 	baseURL := fmt.Sprintf("/%s/%s/access/groups", rc.Level, rc.Identifier)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessGroup{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
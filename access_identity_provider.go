@@ -8,11 +8,52 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// AccessIdentityProviderType is the type of an Access identity provider.
+type AccessIdentityProviderType string
+
+const (
+	AccessIdentityProviderTypeAzureAD    AccessIdentityProviderType = "azureAD"
+	AccessIdentityProviderTypeCentrify   AccessIdentityProviderType = "centrify"
+	AccessIdentityProviderTypeFacebook   AccessIdentityProviderType = "facebook"
+	AccessIdentityProviderTypeGitHub     AccessIdentityProviderType = "github"
+	AccessIdentityProviderTypeGoogle     AccessIdentityProviderType = "google"
+	AccessIdentityProviderTypeGoogleApps AccessIdentityProviderType = "google-apps"
+	AccessIdentityProviderTypeLinkedIn   AccessIdentityProviderType = "linkedin"
+	AccessIdentityProviderTypeOIDC       AccessIdentityProviderType = "oidc"
+	AccessIdentityProviderTypeOkta       AccessIdentityProviderType = "okta"
+	AccessIdentityProviderTypeOneLogin   AccessIdentityProviderType = "onelogin"
+	AccessIdentityProviderTypeOneTimePin AccessIdentityProviderType = "onetimepin"
+	AccessIdentityProviderTypePingOne    AccessIdentityProviderType = "pingone"
+	AccessIdentityProviderTypeSAML       AccessIdentityProviderType = "saml"
+	AccessIdentityProviderTypeYandex     AccessIdentityProviderType = "yandex"
+)
+
+// AccessIdentityProviderTypeValues exposes all the available
+// `AccessIdentityProviderType` values as strings.
+func AccessIdentityProviderTypeValues() []string {
+	return []string{
+		string(AccessIdentityProviderTypeAzureAD),
+		string(AccessIdentityProviderTypeCentrify),
+		string(AccessIdentityProviderTypeFacebook),
+		string(AccessIdentityProviderTypeGitHub),
+		string(AccessIdentityProviderTypeGoogle),
+		string(AccessIdentityProviderTypeGoogleApps),
+		string(AccessIdentityProviderTypeLinkedIn),
+		string(AccessIdentityProviderTypeOIDC),
+		string(AccessIdentityProviderTypeOkta),
+		string(AccessIdentityProviderTypeOneLogin),
+		string(AccessIdentityProviderTypeOneTimePin),
+		string(AccessIdentityProviderTypePingOne),
+		string(AccessIdentityProviderTypeSAML),
+		string(AccessIdentityProviderTypeYandex),
+	}
+}
+
 // AccessIdentityProvider is the structure of the provider object.
 type AccessIdentityProvider struct {
 	ID         string                                  `json:"id,omitempty"`
 	Name       string                                  `json:"name"`
-	Type       string                                  `json:"type"`
+	Type       AccessIdentityProviderType              `json:"type"`
 	Config     AccessIdentityProviderConfiguration     `json:"config"`
 	ScimConfig AccessIdentityProviderScimConfiguration `json:"scim_config"`
 }
@@ -81,7 +122,7 @@ type ListAccessIdentityProvidersParams struct {
 
 type CreateAccessIdentityProviderParams struct {
 	Name       string                                  `json:"name"`
-	Type       string                                  `json:"type"`
+	Type       AccessIdentityProviderType              `json:"type"`
 	Config     AccessIdentityProviderConfiguration     `json:"config"`
 	ScimConfig AccessIdentityProviderScimConfiguration `json:"scim_config"`
 }
@@ -89,7 +130,7 @@ type CreateAccessIdentityProviderParams struct {
 type UpdateAccessIdentityProviderParams struct {
 	ID         string                                  `json:"-"`
 	Name       string                                  `json:"name"`
-	Type       string                                  `json:"type"`
+	Type       AccessIdentityProviderType              `json:"type"`
 	Config     AccessIdentityProviderConfiguration     `json:"config"`
 	ScimConfig AccessIdentityProviderScimConfiguration `json:"scim_config"`
 }
@@ -113,11 +154,17 @@ type AccessAuthContextsListResponse struct {
 // ListAccessIdentityProviders returns all Access Identity Providers for an
 // account or zone.
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API Reference: https://developers.cloudflare.com/api/operations/access-identity-providers-list-access-identity-providers
 // Zone API Reference: https://developers.cloudflare.com/api/operations/zone-level-access-identity-providers-list-access-identity-providers
 func (api *API) ListAccessIdentityProviders(ctx context.Context, rc *ResourceContainer, params ListAccessIdentityProvidersParams) ([]AccessIdentityProvider, *ResultInfo, error) {
 	baseURL := fmt.Sprintf("/%s/%s/access/identity_providers", rc.Level, rc.Identifier)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessIdentityProvider{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
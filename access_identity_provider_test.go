@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -414,3 +415,18 @@ func TestUpdateAccessIdentityProviderAuthContext(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestAccessIdentityProviderTypeJSON(t *testing.T) {
+	b, err := json.Marshal(AccessIdentityProvider{Name: "GitHub", Type: AccessIdentityProviderTypeGitHub})
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), `"type":"github"`)
+	}
+
+	var idp AccessIdentityProvider
+	err = json.Unmarshal([]byte(`{"name": "Workspace", "type": "google-apps"}`), &idp)
+	if assert.NoError(t, err) {
+		assert.Equal(t, AccessIdentityProviderTypeGoogleApps, idp.Type)
+	}
+
+	assert.Contains(t, AccessIdentityProviderTypeValues(), "onetimepin")
+}
@@ -0,0 +1,334 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingAccessJWT         = errors.New("required access JWT missing")
+	ErrMissingAccessAudienceTag = errors.New("required access application audience tag missing")
+	ErrMissingAccessAuthDomain  = errors.New("access organization has no auth domain")
+	ErrInvalidAccessAuthDomain  = errors.New("access auth domain must be a https domain")
+	ErrInvalidAccessJWT         = errors.New("invalid access JWT")
+	ErrAccessJWTExpired         = errors.New("access JWT has expired")
+)
+
+const (
+	// accessKeySetTTL is how long the signing keys of a team are used before
+	// they are fetched again, so that keys removed after a rotation stop
+	// being accepted.
+	accessKeySetTTL = time.Hour
+
+	// accessCertsMaxSize limits how much of the signing keys response is
+	// read.
+	accessCertsMaxSize = 1 << 20
+
+	// accessKeySetMinRefreshInterval limits how often the signing keys are
+	// fetched because a token was signed by an unknown key.
+	accessKeySetMinRefreshInterval = time.Minute
+)
+
+// AccessJWTClaims are the claims of a token issued by Cloudflare Access, as
+// sent to origins in the `Cf-Access-Jwt-Assertion` header.
+type AccessJWTClaims struct {
+	Audience      []string `json:"aud"`
+	Email         string   `json:"email"`
+	ExpiresAt     int64    `json:"exp"`
+	IssuedAt      int64    `json:"iat"`
+	NotBefore     int64    `json:"nbf"`
+	Issuer        string   `json:"iss"`
+	Type          string   `json:"type"`
+	IdentityNonce string   `json:"identity_nonce"`
+	Subject       string   `json:"sub"`
+	Country       string   `json:"country"`
+	// CommonName is set instead of Email for service tokens.
+	CommonName string `json:"common_name"`
+}
+
+// UnmarshalJSON decodes the claims, accepting an `aud` claim that is a single
+// string rather than an array as allowed by RFC 7519.
+func (c *AccessJWTClaims) UnmarshalJSON(data []byte) error {
+	type accessJWTClaims AccessJWTClaims
+	var decoded struct {
+		accessJWTClaims
+		Audience json.RawMessage `json:"aud"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*c = AccessJWTClaims(decoded.accessJWTClaims)
+	if len(decoded.Audience) == 0 || string(decoded.Audience) == "null" {
+		return nil
+	}
+
+	if decoded.Audience[0] == '"' {
+		var audience string
+		if err := json.Unmarshal(decoded.Audience, &audience); err != nil {
+			return err
+		}
+		c.Audience = []string{audience}
+		return nil
+	}
+
+	return json.Unmarshal(decoded.Audience, &c.Audience)
+}
+
+type accessJWTHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type accessJWK struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type accessCertsResponse struct {
+	Keys []accessJWK `json:"keys"`
+}
+
+// accessKeySet holds the signing keys of an Access team.
+type accessKeySet struct {
+	issuer  string
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// accessKeySetCache remembers the signing keys of Access teams by the
+// resource container of their organization.
+type accessKeySetCache struct {
+	mu   sync.Mutex
+	sets map[string]*accessKeySet
+	now  func() time.Time
+}
+
+func newAccessKeySetCache() *accessKeySetCache {
+	return &accessKeySetCache{
+		sets: make(map[string]*accessKeySet),
+		now:  time.Now,
+	}
+}
+
+func (c *accessKeySetCache) get(rc *ResourceContainer) *accessKeySet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sets[string(rc.Level)+"/"+rc.Identifier]
+}
+
+func (c *accessKeySetCache) set(rc *ResourceContainer, set *accessKeySet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sets[string(rc.Level)+"/"+rc.Identifier] = set
+}
+
+// VerifyAccessJWT validates a token issued by Cloudflare Access for the
+// application with the audience tag and returns its claims. The signature is
+// checked against the public keys of the team's auth domain, which are cached
+// and fetched again periodically or when a token is signed by an unknown key
+// after a rotation. The issuer, audience, expiry and not before claims are
+// verified.
+func (api *API) VerifyAccessJWT(ctx context.Context, rc *ResourceContainer, token string, audTag string) (AccessJWTClaims, error) {
+	if rc.Identifier == "" {
+		return AccessJWTClaims{}, ErrMissingResourceIdentifier
+	}
+
+	if token == "" {
+		return AccessJWTClaims{}, ErrMissingAccessJWT
+	}
+
+	if audTag == "" {
+		return AccessJWTClaims{}, ErrMissingAccessAudienceTag
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return AccessJWTClaims{}, fmt.Errorf("%w: malformed token", ErrInvalidAccessJWT)
+	}
+
+	var header accessJWTHeader
+	if err := decodeAccessJWTSegment(parts[0], &header); err != nil {
+		return AccessJWTClaims{}, err
+	}
+
+	if header.Algorithm != "RS256" {
+		return AccessJWTClaims{}, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidAccessJWT, header.Algorithm)
+	}
+
+	set, err := api.accessKeySet(ctx, rc, header.KeyID)
+	if err != nil {
+		return AccessJWTClaims{}, err
+	}
+
+	key, ok := set.keys[header.KeyID]
+	if !ok {
+		return AccessJWTClaims{}, fmt.Errorf("%w: unknown signing key %q", ErrInvalidAccessJWT, header.KeyID)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return AccessJWTClaims{}, fmt.Errorf("%w: %s", ErrInvalidAccessJWT, err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return AccessJWTClaims{}, fmt.Errorf("%w: %s", ErrInvalidAccessJWT, err)
+	}
+
+	var claims AccessJWTClaims
+	if err := decodeAccessJWTSegment(parts[1], &claims); err != nil {
+		return AccessJWTClaims{}, err
+	}
+
+	if claims.Issuer != set.issuer {
+		return AccessJWTClaims{}, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidAccessJWT, claims.Issuer)
+	}
+
+	if !contains(claims.Audience, audTag) {
+		return AccessJWTClaims{}, fmt.Errorf("%w: audience does not include %q", ErrInvalidAccessJWT, audTag)
+	}
+
+	now := api.accessKeys.now().Unix()
+	if claims.ExpiresAt <= now {
+		return AccessJWTClaims{}, ErrAccessJWTExpired
+	}
+
+	if claims.NotBefore > now {
+		return AccessJWTClaims{}, fmt.Errorf("%w: token not valid yet", ErrInvalidAccessJWT)
+	}
+
+	return claims, nil
+}
+
+// accessKeySet returns the cached signing keys for the resource container,
+// fetching them when they are missing, older than accessKeySetTTL or do not
+// contain the key the token was signed with.
+func (api *API) accessKeySet(ctx context.Context, rc *ResourceContainer, keyID string) (*accessKeySet, error) {
+	now := api.accessKeys.now()
+
+	set := api.accessKeys.get(rc)
+	if set != nil && now.Sub(set.fetched) < accessKeySetTTL {
+		if _, ok := set.keys[keyID]; ok || now.Sub(set.fetched) < accessKeySetMinRefreshInterval {
+			return set, nil
+		}
+	}
+
+	issuer := ""
+	if set != nil {
+		issuer = set.issuer
+	} else {
+		org, _, err := api.GetAccessOrganization(ctx, rc, GetAccessOrganizationParams{})
+		if err != nil {
+			return nil, err
+		}
+
+		if org.AuthDomain == "" {
+			return nil, ErrMissingAccessAuthDomain
+		}
+
+		issuer, err = accessIssuer(org.AuthDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := api.fetchAccessSigningKeys(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	set = &accessKeySet{issuer: issuer, keys: keys, fetched: now}
+	api.accessKeys.set(rc, set)
+
+	return set, nil
+}
+
+// fetchAccessSigningKeys downloads the public keys used to sign the tokens of
+// an Access team.
+func (api *API) fetchAccessSigningKeys(ctx context.Context, issuer string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/cdn-cgi/access/certs", nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+
+	resp, err := api.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching access signing keys failed: %s", resp.Status)
+	}
+
+	var certs accessCertsResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, accessCertsMaxSize)).Decode(&certs); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(certs.Keys))
+	for _, k := range certs.Keys {
+		if k.KeyType != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus for access signing key %q: %w", k.KeyID, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent for access signing key %q: %w", k.KeyID, err)
+		}
+
+		keys[k.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+// accessIssuer returns the issuer of tokens for an auth domain such as
+// `example.cloudflareaccess.com`. Tokens are only ever issued over https.
+func accessIssuer(authDomain string) (string, error) {
+	domain := strings.TrimSuffix(strings.TrimPrefix(authDomain, "https://"), "/")
+	if domain == "" || strings.Contains(domain, "://") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidAccessAuthDomain, authDomain)
+	}
+
+	return "https://" + domain, nil
+}
+
+func decodeAccessJWTSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAccessJWT, err)
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAccessJWT, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccessAudienceTag = "737646a56ab1df6ec9bddc7e5ca84eaf3b0768850f3ffb5d74f1534911fe3893"
+	testAccessAuthDomain  = "example.cloudflareaccess.com"
+	testAccessIssuer      = "https://" + testAccessAuthDomain
+)
+
+func signTestAccessJWT(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func handleTestAccessCerts(t *testing.T, kid string, key *rsa.PublicKey, fetches *int) {
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/organizations", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"name": "example", "auth_domain": "%s"}}`, testAccessAuthDomain)
+	})
+
+	mux.HandleFunc("/cdn-cgi/access/certs", func(w http.ResponseWriter, r *http.Request) {
+		*fetches++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"keys": [{"kid": "%s", "kty": "RSA", "alg": "RS256", "use": "sig", "e": "%s", "n": "%s"}]}`,
+			kid,
+			base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			base64.RawURLEncoding.EncodeToString(key.N.Bytes()))
+	})
+}
+
+func TestVerifyAccessJWT(t *testing.T) {
+	setup(WithDoer(testServerDoer(testAccessAuthDomain)))
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	fetches := 0
+	handleTestAccessCerts(t, "key-1", &key.PublicKey, &fetches)
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"aud":   []string{testAccessAudienceTag},
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"nbf":   now.Unix(),
+		"iss":   testAccessIssuer,
+		"type":  "app",
+		"sub":   "7335d417-61da-459d-899c-0a01c76a2f94",
+	}
+	token := signTestAccessJWT(t, key, "key-1", claims)
+
+	actual, err := client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), token, testAccessAudienceTag)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user@example.com", actual.Email)
+		assert.Equal(t, []string{testAccessAudienceTag}, actual.Audience)
+		assert.Equal(t, "7335d417-61da-459d-899c-0a01c76a2f94", actual.Subject)
+	}
+
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), token, "another-application")
+	assert.ErrorIs(t, err, ErrInvalidAccessJWT)
+
+	// A single audience may be a string rather than an array.
+	claims["aud"] = testAccessAudienceTag
+	actual, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), signTestAccessJWT(t, key, "key-1", claims), testAccessAudienceTag)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{testAccessAudienceTag}, actual.Audience)
+	}
+	claims["aud"] = []string{testAccessAudienceTag}
+
+	claims["exp"] = now.Add(-time.Minute).Unix()
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), signTestAccessJWT(t, key, "key-1", claims), testAccessAudienceTag)
+	assert.ErrorIs(t, err, ErrAccessJWTExpired)
+
+	claims["exp"] = now.Add(time.Hour).Unix()
+	claims["iss"] = "https://attacker.cloudflareaccess.com"
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), signTestAccessJWT(t, key, "key-1", claims), testAccessAudienceTag)
+	assert.ErrorIs(t, err, ErrInvalidAccessJWT)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	claims["iss"] = testAccessIssuer
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), signTestAccessJWT(t, otherKey, "key-1", claims), testAccessAudienceTag)
+	assert.ErrorIs(t, err, ErrInvalidAccessJWT)
+
+	assert.Equal(t, 1, fetches, "signing keys should be cached")
+}
+
+func TestVerifyAccessJWT_KeyRotation(t *testing.T) {
+	setup(WithDoer(testServerDoer(testAccessAuthDomain)))
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	fetches := 0
+	handleTestAccessCerts(t, "key-2", &key.PublicKey, &fetches)
+
+	now := time.Now()
+	client.accessKeys.now = func() time.Time { return now }
+	client.accessKeys.set(AccountIdentifier(testAccountID), &accessKeySet{
+		issuer:  testAccessIssuer,
+		keys:    map[string]*rsa.PublicKey{},
+		fetched: now.Add(-2 * accessKeySetMinRefreshInterval),
+	})
+
+	token := signTestAccessJWT(t, key, "key-2", map[string]interface{}{
+		"aud": []string{testAccessAudienceTag},
+		"exp": now.Add(time.Hour).Unix(),
+		"iss": testAccessIssuer,
+	})
+
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), token, testAccessAudienceTag)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetches, "an unknown key should refresh the signing keys")
+}
+
+func TestVerifyAccessJWT_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), "", testAccessAudienceTag)
+	assert.ErrorIs(t, err, ErrMissingAccessJWT)
+
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), "a.b.c", "")
+	assert.ErrorIs(t, err, ErrMissingAccessAudienceTag)
+
+	_, err = client.VerifyAccessJWT(context.Background(), AccountIdentifier(testAccountID), "not-a-jwt", testAccessAudienceTag)
+	assert.ErrorIs(t, err, ErrInvalidAccessJWT)
+}
+
+func TestAccessIssuer(t *testing.T) {
+	for domain, want := range map[string]string{
+		"example.cloudflareaccess.com":          "https://example.cloudflareaccess.com",
+		"https://example.cloudflareaccess.com/": "https://example.cloudflareaccess.com",
+	} {
+		issuer, err := accessIssuer(domain)
+		if assert.NoError(t, err, domain) {
+			assert.Equal(t, want, issuer)
+		}
+	}
+
+	_, err := accessIssuer("http://example.cloudflareaccess.com")
+	assert.ErrorIs(t, err, ErrInvalidAccessAuthDomain)
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,17 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Bounds of the number of days between automatic rotations of the keys that
+// sign Access JWTs.
+const (
+	AccessKeysMinRotationIntervalDays = 21
+	AccessKeysMaxRotationIntervalDays = 365
+)
+
+var ErrInvalidAccessKeysRotationInterval = errors.New("key rotation interval must be between 21 and 365 days")
+
+// AccessKeysConfig describes how often the keys that sign Access JWTs are
+// rotated.
 type AccessKeysConfig struct {
 	KeyRotationIntervalDays int       `json:"key_rotation_interval_days"`
 	LastKeyRotationAt       time.Time `json:"last_key_rotation_at"`
@@ -28,15 +40,28 @@ type accessKeysConfigResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-keys-configuration-get-access-keys-configuration
 func (api *API) AccessKeysConfig(ctx context.Context, accountID string) (AccessKeysConfig, error) {
+	if accountID == "" {
+		return AccessKeysConfig{}, ErrMissingAccountID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/keys", AccountRouteRoot, accountID)
 
 	return api.accessKeysRequest(ctx, http.MethodGet, uri, nil)
 }
 
 // UpdateAccessKeysConfig updates the Access Keys Configuration for an account.
+// The rotation interval must be between 21 and 365 days.
 //
 // API reference: https://api.cloudflare.com/#access-keys-configuration-update-access-keys-configuration
 func (api *API) UpdateAccessKeysConfig(ctx context.Context, accountID string, request AccessKeysConfigUpdateRequest) (AccessKeysConfig, error) {
+	if accountID == "" {
+		return AccessKeysConfig{}, ErrMissingAccountID
+	}
+
+	if request.KeyRotationIntervalDays < AccessKeysMinRotationIntervalDays || request.KeyRotationIntervalDays > AccessKeysMaxRotationIntervalDays {
+		return AccessKeysConfig{}, ErrInvalidAccessKeysRotationInterval
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/keys", AccountRouteRoot, accountID)
 
 	return api.accessKeysRequest(ctx, http.MethodPut, uri, request)
@@ -46,6 +71,10 @@ func (api *API) UpdateAccessKeysConfig(ctx context.Context, accountID string, re
 //
 // API reference: https://api.cloudflare.com/#access-keys-configuration-rotate-access-keys
 func (api *API) RotateAccessKeys(ctx context.Context, accountID string) (AccessKeysConfig, error) {
+	if accountID == "" {
+		return AccessKeysConfig{}, ErrMissingAccountID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/keys/rotate", AccountRouteRoot, accountID)
 	return api.accessKeysRequest(ctx, http.MethodPost, uri, nil)
 }
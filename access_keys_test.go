@@ -122,3 +122,17 @@ func TestRotateAccessKeys(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestUpdateAccessKeysConfig_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateAccessKeysConfig(context.Background(), "", AccessKeysConfigUpdateRequest{KeyRotationIntervalDays: 30})
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
+	_, err = client.UpdateAccessKeysConfig(context.Background(), testAccountID, AccessKeysConfigUpdateRequest{KeyRotationIntervalDays: 7})
+	assert.ErrorIs(t, err, ErrInvalidAccessKeysRotationInterval)
+
+	_, err = client.RotateAccessKeys(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+}
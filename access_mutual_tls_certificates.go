@@ -60,6 +60,8 @@ type UpdateAccessMutualTLSCertificateParams struct {
 
 // ListAccessMutualTLSCertificates returns all Access TLS certificates
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API Reference: https://developers.cloudflare.com/api/operations/access-mtls-authentication-list-mtls-certificates
 // Zone API Reference: https://developers.cloudflare.com/api/operations/zone-level-access-mtls-authentication-list-mtls-certificates
 func (api *API) ListAccessMutualTLSCertificates(ctx context.Context, rc *ResourceContainer, params ListAccessMutualTLSCertificatesParams) ([]AccessMutualTLSCertificate, *ResultInfo, error) {
@@ -69,6 +71,10 @@ func (api *API) ListAccessMutualTLSCertificates(ctx context.Context, rc *Resourc
 		rc.Identifier,
 	)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessMutualTLSCertificate{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
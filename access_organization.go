@@ -81,7 +81,16 @@ type UpdateAccessOrganizationParams struct {
 	CustomPages                    AccessOrganizationCustomPages `json:"custom_pages,omitempty"`
 }
 
+// GetAccessOrganization returns the Access organization, which holds the
+// team domain, the session duration and the login page design.
+//
+// Account API reference: https://developers.cloudflare.com/api/operations/zero-trust-organization-get-your-zero-trust-organization
+// Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-zero-trust-organization-get-your-zero-trust-organization
 func (api *API) GetAccessOrganization(ctx context.Context, rc *ResourceContainer, params GetAccessOrganizationParams) (AccessOrganization, ResultInfo, error) {
+	if rc.Identifier == "" {
+		return AccessOrganization{}, ResultInfo{}, ErrMissingResourceIdentifier
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/organizations", rc.Level, rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
@@ -98,7 +107,16 @@ func (api *API) GetAccessOrganization(ctx context.Context, rc *ResourceContainer
 	return accessOrganizationListResponse.Result, accessOrganizationListResponse.ResultInfo, nil
 }
 
+// CreateAccessOrganization sets up the Access organization with its team
+// domain. Other Access resources can only be created once it exists.
+//
+// Account API reference: https://developers.cloudflare.com/api/operations/zero-trust-organization-create-your-zero-trust-organization
+// Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-zero-trust-organization-create-your-zero-trust-organization
 func (api *API) CreateAccessOrganization(ctx context.Context, rc *ResourceContainer, params CreateAccessOrganizationParams) (AccessOrganization, error) {
+	if rc.Identifier == "" {
+		return AccessOrganization{}, ErrMissingResourceIdentifier
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/organizations", rc.Level, rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
@@ -120,6 +138,10 @@ func (api *API) CreateAccessOrganization(ctx context.Context, rc *ResourceContai
 // Account API reference: https://api.cloudflare.com/#access-organizations-update-access-organization
 // Zone API reference: https://api.cloudflare.com/#zone-level-access-organizations-update-access-organization
 func (api *API) UpdateAccessOrganization(ctx context.Context, rc *ResourceContainer, params UpdateAccessOrganizationParams) (AccessOrganization, error) {
+	if rc.Identifier == "" {
+		return AccessOrganization{}, ErrMissingResourceIdentifier
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/organizations", rc.Level, rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
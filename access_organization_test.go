@@ -263,3 +263,17 @@ func TestUpdateAccessOrganization(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestAccessOrganization_MissingIdentifier(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.GetAccessOrganization(context.Background(), AccountIdentifier(""), GetAccessOrganizationParams{})
+	assert.ErrorIs(t, err, ErrMissingResourceIdentifier)
+
+	_, err = client.CreateAccessOrganization(context.Background(), AccountIdentifier(""), CreateAccessOrganizationParams{})
+	assert.ErrorIs(t, err, ErrMissingResourceIdentifier)
+
+	_, err = client.UpdateAccessOrganization(context.Background(), ZoneIdentifier(""), UpdateAccessOrganizationParams{})
+	assert.ErrorIs(t, err, ErrMissingResourceIdentifier)
+}
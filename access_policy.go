@@ -139,6 +139,8 @@ type DeleteAccessPolicyParams struct {
 
 // ListAccessPolicies returns all access policies for an access application.
 //
+// `params.PerPage` can be at most 1000.
+//
 // Account API reference: https://developers.cloudflare.com/api/operations/access-policies-list-access-policies
 // Zone API reference: https://developers.cloudflare.com/api/operations/zone-level-access-policies-list-access-policies
 func (api *API) ListAccessPolicies(ctx context.Context, rc *ResourceContainer, params ListAccessPoliciesParams) ([]AccessPolicy, *ResultInfo, error) {
@@ -153,6 +155,10 @@ func (api *API) ListAccessPolicies(ctx context.Context, rc *ResourceContainer, p
 		params.ApplicationID,
 	)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessPolicy{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
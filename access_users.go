@@ -201,6 +201,8 @@ type GetAccessUserSingleActiveSessionResult struct {
 
 // ListAccessUsers returns a list of users for a single cloudflare access/zerotrust account.
 //
+// `params.PerPage` can be at most 1000.
+//
 // API documentation: https://developers.cloudflare.com/api/operations/zero-trust-users-get-users
 func (api *API) ListAccessUsers(ctx context.Context, rc *ResourceContainer, params AccessUserParams) ([]AccessUser, *ResultInfo, error) {
 	if rc.Level != AccountRouteLevel {
@@ -209,6 +211,10 @@ func (api *API) ListAccessUsers(ctx context.Context, rc *ResourceContainer, para
 
 	baseURL := fmt.Sprintf("/%s/%s/access/users", rc.Level, rc.Identifier)
 
+	if err := checkPerPage(params.PerPage, 1, accessMaxPerPage); err != nil {
+		return []AccessUser{}, &ResultInfo{}, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
@@ -11,7 +11,8 @@ import (
 
 // AccountSettings outlines the available options for an account.
 type AccountSettings struct {
-	EnforceTwoFactor bool `json:"enforce_twofactor"`
+	EnforceTwoFactor  bool   `json:"enforce_twofactor"`
+	AbuseContactEmail string `json:"abuse_contact_email,omitempty"`
 }
 
 // Account represents the root object that owns resources.
@@ -110,6 +111,55 @@ func (api *API) UpdateAccount(ctx context.Context, accountID string, account Acc
 	return a.Result, nil
 }
 
+// GetAccountSettings returns the settings of an account, such as whether
+// two-factor authentication is enforced for its members.
+//
+// API reference: https://api.cloudflare.com/#accounts-account-details
+func (api *API) GetAccountSettings(ctx context.Context, accountID string) (AccountSettings, error) {
+	if accountID == "" {
+		return AccountSettings{}, ErrMissingAccountID
+	}
+
+	account, _, err := api.Account(ctx, accountID)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	if account.Settings == nil {
+		return AccountSettings{}, nil
+	}
+
+	return *account.Settings, nil
+}
+
+// UpdateAccountSettings replaces the settings of an account. The account is
+// fetched first as the API requires its name to be sent alongside the
+// settings.
+//
+// API reference: https://api.cloudflare.com/#accounts-update-account
+func (api *API) UpdateAccountSettings(ctx context.Context, accountID string, settings AccountSettings) (AccountSettings, error) {
+	if accountID == "" {
+		return AccountSettings{}, ErrMissingAccountID
+	}
+
+	account, _, err := api.Account(ctx, accountID)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	account.Settings = &settings
+	account, err = api.UpdateAccount(ctx, accountID, account)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	if account.Settings == nil {
+		return AccountSettings{}, nil
+	}
+
+	return *account.Settings, nil
+}
+
 // CreateAccount creates a new account. Note: This requires the Tenant
 // entitlement.
 //
@@ -225,3 +225,56 @@ func TestDeleteAccount(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestUpdateAccountSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823", func(w http.ResponseWriter, r *http.Request) {
+		settings := `{"enforce_twofactor": false}`
+		if r.Method == http.MethodPut {
+			b, err := io.ReadAll(r.Body)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+					"id": "01a7362d577a6c3019a474fd6f485823",
+					"name": "Cloudflare Demo",
+					"created_on": "2014-01-01T05:20:00.12345Z",
+					"settings": {
+						"enforce_twofactor": true,
+						"abuse_contact_email": "abuse@example.com"
+					}
+				}`, string(b))
+			}
+			settings = `{"enforce_twofactor": true, "abuse_contact_email": "abuse@example.com"}`
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "01a7362d577a6c3019a474fd6f485823",
+				"name": "Cloudflare Demo",
+				"created_on": "2014-01-01T05:20:00.12345Z",
+				"settings": %s
+			}
+		}`, settings)
+	})
+
+	_, err := client.GetAccountSettings(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
+	settings, err := client.GetAccountSettings(context.Background(), "01a7362d577a6c3019a474fd6f485823")
+	if assert.NoError(t, err) {
+		assert.Equal(t, AccountSettings{EnforceTwoFactor: false}, settings)
+	}
+
+	want := AccountSettings{EnforceTwoFactor: true, AbuseContactEmail: "abuse@example.com"}
+	settings, err = client.UpdateAccountSettings(context.Background(), "01a7362d577a6c3019a474fd6f485823", want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, settings)
+	}
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var ErrMissingIPPrefixID = errors.New("required IP prefix ID missing")
+
 // IPPrefix contains information about an IP prefix.
 type IPPrefix struct {
 	ID                   string     `json:"id"`
@@ -62,6 +65,10 @@ type AdvertisementStatusUpdateRequest struct {
 //
 // API reference: https://api.cloudflare.com/#ip-address-management-prefixes-list-prefixes
 func (api *API) ListPrefixes(ctx context.Context, accountID string) ([]IPPrefix, error) {
+	if accountID == "" {
+		return []IPPrefix{}, ErrMissingAccountID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes", accountID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -80,6 +87,14 @@ func (api *API) ListPrefixes(ctx context.Context, accountID string) ([]IPPrefix,
 //
 // API reference: https://api.cloudflare.com/#ip-address-management-prefixes-prefix-details
 func (api *API) GetPrefix(ctx context.Context, accountID, ID string) (IPPrefix, error) {
+	if accountID == "" {
+		return IPPrefix{}, ErrMissingAccountID
+	}
+
+	if ID == "" {
+		return IPPrefix{}, ErrMissingIPPrefixID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s", accountID, ID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -98,6 +113,14 @@ func (api *API) GetPrefix(ctx context.Context, accountID, ID string) (IPPrefix,
 //
 // API reference: https://api.cloudflare.com/#ip-address-management-prefixes-update-prefix-description
 func (api *API) UpdatePrefixDescription(ctx context.Context, accountID, ID string, description string) (IPPrefix, error) {
+	if accountID == "" {
+		return IPPrefix{}, ErrMissingAccountID
+	}
+
+	if ID == "" {
+		return IPPrefix{}, ErrMissingIPPrefixID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s", accountID, ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, IPPrefixUpdateRequest{Description: description})
 	if err != nil {
@@ -112,10 +135,20 @@ func (api *API) UpdatePrefixDescription(ctx context.Context, accountID, ID strin
 	return result.Result, nil
 }
 
-// GetAdvertisementStatus returns the BGP status of the IP prefix
+// GetAdvertisementStatus returns the BGP status of the IP prefix. The
+// AdvertisedModifiedAt timestamp can be polled after UpdateAdvertisementStatus
+// to confirm when the change took effect.
 //
-// API reference: https://api.cloudflare.com/#ip-address-management-prefixes-update-prefix-description
+// API reference: https://api.cloudflare.com/#ip-address-management-dynamic-advertisement-get-advertisement-status
 func (api *API) GetAdvertisementStatus(ctx context.Context, accountID, ID string) (AdvertisementStatus, error) {
+	if accountID == "" {
+		return AdvertisementStatus{}, ErrMissingAccountID
+	}
+
+	if ID == "" {
+		return AdvertisementStatus{}, ErrMissingIPPrefixID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/bgp/status", accountID, ID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -132,8 +165,16 @@ func (api *API) GetAdvertisementStatus(ctx context.Context, accountID, ID string
 
 // UpdateAdvertisementStatus changes the BGP status of an IP prefix
 //
-// API reference: https://api.cloudflare.com/#ip-address-management-prefixes-update-prefix-description
+// API reference: https://api.cloudflare.com/#ip-address-management-dynamic-advertisement-update-prefix-dynamic-advertisement-status
 func (api *API) UpdateAdvertisementStatus(ctx context.Context, accountID, ID string, advertised bool) (AdvertisementStatus, error) {
+	if accountID == "" {
+		return AdvertisementStatus{}, ErrMissingAccountID
+	}
+
+	if ID == "" {
+		return AdvertisementStatus{}, ErrMissingIPPrefixID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/bgp/status", accountID, ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, AdvertisementStatusUpdateRequest{Advertised: advertised})
 	if err != nil {
@@ -235,3 +235,20 @@ func TestUpdateAdvertisementStatus(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestIPPrefix_MissingIdentifiers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.ListPrefixes(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
+	_, err = client.GetPrefix(context.Background(), "", "f68579455bd947efb65ffa1bcf33b52c")
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
+	_, err = client.GetAdvertisementStatus(context.Background(), testAccountID, "")
+	assert.ErrorIs(t, err, ErrMissingIPPrefixID)
+
+	_, err = client.UpdateAdvertisementStatus(context.Background(), testAccountID, "", true)
+	assert.ErrorIs(t, err, ErrMissingIPPrefixID)
+}
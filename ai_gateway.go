@@ -0,0 +1,302 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingAIGatewayID = errors.New("required AI Gateway ID missing")
+)
+
+// AIGatewayRateLimitingTechnique is how requests are counted against the
+// rate limit of a gateway.
+type AIGatewayRateLimitingTechnique string
+
+const (
+	AIGatewayRateLimitingTechniqueFixed   AIGatewayRateLimitingTechnique = "fixed"
+	AIGatewayRateLimitingTechniqueSliding AIGatewayRateLimitingTechnique = "sliding"
+)
+
+// AIGateway is an AI Gateway proxying requests to AI providers.
+type AIGateway struct {
+	ID                      string                         `json:"id"`
+	CacheTTL                int                            `json:"cache_ttl"`
+	CacheInvalidateOnUpdate bool                           `json:"cache_invalidate_on_update"`
+	CollectLogs             bool                           `json:"collect_logs"`
+	RateLimitingInterval    int                            `json:"rate_limiting_interval"`
+	RateLimitingLimit       int                            `json:"rate_limiting_limit"`
+	RateLimitingTechnique   AIGatewayRateLimitingTechnique `json:"rate_limiting_technique"`
+	Authentication          bool                           `json:"authentication"`
+	CreatedAt               *time.Time                     `json:"created_at,omitempty"`
+	ModifiedAt              *time.Time                     `json:"modified_at,omitempty"`
+}
+
+// AIGatewayParams are the settings of a gateway when creating or updating it.
+// A CacheTTL of 0 disables caching and a RateLimitingLimit of 0 disables rate
+// limiting.
+type AIGatewayParams struct {
+	ID                      string                         `json:"id,omitempty"`
+	CacheTTL                int                            `json:"cache_ttl"`
+	CacheInvalidateOnUpdate bool                           `json:"cache_invalidate_on_update"`
+	CollectLogs             bool                           `json:"collect_logs"`
+	RateLimitingInterval    int                            `json:"rate_limiting_interval"`
+	RateLimitingLimit       int                            `json:"rate_limiting_limit"`
+	RateLimitingTechnique   AIGatewayRateLimitingTechnique `json:"rate_limiting_technique"`
+	Authentication          bool                           `json:"authentication"`
+}
+
+type ListAIGatewaysParams struct {
+	Search string `url:"search,omitempty"`
+	ResultInfo
+}
+
+// AIGatewayLog is a request logged by a gateway.
+type AIGatewayLog struct {
+	ID         string     `json:"id"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Provider   string     `json:"provider"`
+	Model      string     `json:"model"`
+	Path       string     `json:"path"`
+	StatusCode int        `json:"status_code"`
+	Success    bool       `json:"success"`
+	Cached     bool       `json:"cached"`
+	Duration   int        `json:"duration"`
+	TokensIn   int        `json:"tokens_in"`
+	TokensOut  int        `json:"tokens_out"`
+	Cost       float64    `json:"cost"`
+}
+
+type ListAIGatewayLogsParams struct {
+	Search    string `url:"search,omitempty"`
+	Provider  string `url:"provider,omitempty"`
+	Model     string `url:"model,omitempty"`
+	Success   *bool  `url:"success,omitempty"`
+	Cached    *bool  `url:"cached,omitempty"`
+	OrderBy   string `url:"order_by,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ResultInfo
+}
+
+type AIGatewayResponse struct {
+	Result AIGateway `json:"result"`
+	Response
+}
+
+type ListAIGatewaysResponse struct {
+	Result []AIGateway `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+type ListAIGatewayLogsResponse struct {
+	Result []AIGatewayLog `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// ListAIGateways returns the AI Gateways of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-list-gateway
+func (api *API) ListAIGateways(ctx context.Context, rc *ResourceContainer, params ListAIGatewaysParams) ([]AIGateway, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AIGateway{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AIGateway{}, &ResultInfo{}, ErrMissingAccountID
+	}
+
+	baseURL := fmt.Sprintf("/accounts/%s/ai-gateway/gateways", rc.Identifier)
+	autoPaginate := true
+	if params.PerPage >= 1 || params.Page >= 1 {
+		autoPaginate = false
+	}
+
+	if params.PerPage < 1 {
+		params.PerPage = 50
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	var gateways []AIGateway
+	var r ListAIGatewaysResponse
+	for {
+		uri := buildURI(baseURL, params)
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AIGateway{}, &ResultInfo{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+		}
+
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AIGateway{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		gateways = append(gateways, r.Result...)
+		params.ResultInfo = r.ResultInfo.Next()
+		if params.ResultInfo.Done() || !autoPaginate {
+			break
+		}
+	}
+	return gateways, &r.ResultInfo, nil
+}
+
+// CreateAIGateway creates an AI Gateway. The ID becomes part of the gateway's
+// endpoint URL.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-create-gateway
+func (api *API) CreateAIGateway(ctx context.Context, rc *ResourceContainer, params AIGatewayParams) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if params.ID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	if params.RateLimitingTechnique == "" {
+		params.RateLimitingTechnique = AIGatewayRateLimitingTechniqueFixed
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return AIGateway{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalAIGateway(res)
+}
+
+// GetAIGateway returns a single AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-fetch-gateway
+func (api *API) GetAIGateway(ctx context.Context, rc *ResourceContainer, gatewayID string) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, gatewayID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AIGateway{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalAIGateway(res)
+}
+
+// UpdateAIGateway replaces the settings of the AI Gateway identified by
+// params.ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-update-gateway
+func (api *API) UpdateAIGateway(ctx context.Context, rc *ResourceContainer, params AIGatewayParams) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if params.ID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	if params.RateLimitingTechnique == "" {
+		params.RateLimitingTechnique = AIGatewayRateLimitingTechniqueFixed
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, params.ID)
+	params.ID = ""
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return AIGateway{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalAIGateway(res)
+}
+
+// DeleteAIGateway deletes an AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-delete-gateway
+func (api *API) DeleteAIGateway(ctx context.Context, rc *ResourceContainer, gatewayID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, gatewayID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return nil
+}
+
+// ListAIGatewayLogs returns the requests logged by an AI Gateway. Logs are
+// only collected when CollectLogs is enabled for the gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-list-gateway-logs
+func (api *API) ListAIGatewayLogs(ctx context.Context, rc *ResourceContainer, gatewayID string, params ListAIGatewayLogsParams) ([]AIGatewayLog, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrMissingAIGatewayID
+	}
+
+	uri := buildURI(fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s/logs", rc.Identifier, gatewayID), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AIGatewayLog{}, &ResultInfo{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r ListAIGatewayLogsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AIGatewayLog{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, &r.ResultInfo, nil
+}
+
+func unmarshalAIGateway(res []byte) (AIGateway, error) {
+	var r AIGatewayResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return AIGateway{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
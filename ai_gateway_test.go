@@ -0,0 +1,209 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAIGatewayID = "llm-proxy"
+
+const testAIGatewayJSON = `{
+  "id": "llm-proxy",
+  "cache_ttl": 300,
+  "cache_invalidate_on_update": false,
+  "collect_logs": true,
+  "rate_limiting_interval": 60,
+  "rate_limiting_limit": 100,
+  "rate_limiting_technique": "fixed",
+  "authentication": true,
+  "created_at": "2024-05-01T12:00:00Z",
+  "modified_at": "2024-05-01T12:00:00Z"
+}`
+
+func testAIGateway() AIGateway {
+	created := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	return AIGateway{
+		ID:                    testAIGatewayID,
+		CacheTTL:              300,
+		CollectLogs:           true,
+		RateLimitingInterval:  60,
+		RateLimitingLimit:     100,
+		RateLimitingTechnique: AIGatewayRateLimitingTechniqueFixed,
+		Authentication:        true,
+		CreatedAt:             &created,
+		ModifiedAt:            &created,
+	}
+}
+
+func TestListAIGateways(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [%s],
+  "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+}`, testAIGatewayJSON)
+	})
+
+	actual, _, err := client.ListAIGateways(context.Background(), AccountIdentifier(testAccountID), ListAIGatewaysParams{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AIGateway{testAIGateway()}, actual)
+	}
+}
+
+func TestCreateAIGateway(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "llm-proxy",
+  "cache_ttl": 300,
+  "cache_invalidate_on_update": false,
+  "collect_logs": true,
+  "rate_limiting_interval": 60,
+  "rate_limiting_limit": 100,
+  "rate_limiting_technique": "fixed",
+  "authentication": true
+}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testAIGatewayJSON)
+	})
+
+	params := AIGatewayParams{
+		ID:                   testAIGatewayID,
+		CacheTTL:             300,
+		CollectLogs:          true,
+		RateLimitingInterval: 60,
+		RateLimitingLimit:    100,
+		Authentication:       true,
+	}
+
+	_, err := client.CreateAIGateway(context.Background(), AccountIdentifier(testAccountID), AIGatewayParams{})
+	assert.ErrorIs(t, err, ErrMissingAIGatewayID)
+
+	actual, err := client.CreateAIGateway(context.Background(), AccountIdentifier(testAccountID), params)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAIGateway(), actual)
+	}
+}
+
+func TestUpdateAIGateway(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways/"+testAIGatewayID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "cache_ttl": 300,
+  "cache_invalidate_on_update": false,
+  "collect_logs": true,
+  "rate_limiting_interval": 60,
+  "rate_limiting_limit": 100,
+  "rate_limiting_technique": "sliding",
+  "authentication": true
+}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testAIGatewayJSON)
+	})
+
+	_, err := client.UpdateAIGateway(context.Background(), AccountIdentifier(testAccountID), AIGatewayParams{
+		ID:                    testAIGatewayID,
+		CacheTTL:              300,
+		CollectLogs:           true,
+		RateLimitingInterval:  60,
+		RateLimitingLimit:     100,
+		RateLimitingTechnique: AIGatewayRateLimitingTechniqueSliding,
+		Authentication:        true,
+	})
+	assert.NoError(t, err)
+}
+
+func TestDeleteAIGateway(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways/"+testAIGatewayID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testAIGatewayJSON)
+	})
+
+	err := client.DeleteAIGateway(context.Background(), AccountIdentifier(testAccountID), testAIGatewayID)
+	assert.NoError(t, err)
+}
+
+func TestListAIGatewayLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways/"+testAIGatewayID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "openai", r.URL.Query().Get("provider"))
+		assert.Equal(t, "true", r.URL.Query().Get("cached"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "01HWXJ5G3C9GNKF4KH6Q3XWBQ9",
+      "created_at": "2024-05-01T12:30:00Z",
+      "provider": "openai",
+      "model": "gpt-4o-mini",
+      "path": "chat/completions",
+      "status_code": 200,
+      "success": true,
+      "cached": true,
+      "duration": 12,
+      "tokens_in": 25,
+      "tokens_out": 100,
+      "cost": 0.00006
+    }
+  ],
+  "result_info": {"page": 1, "per_page": 20, "count": 1, "total_count": 1}
+}`)
+	})
+
+	created := time.Date(2024, time.May, 1, 12, 30, 0, 0, time.UTC)
+	want := []AIGatewayLog{{
+		ID:         "01HWXJ5G3C9GNKF4KH6Q3XWBQ9",
+		CreatedAt:  &created,
+		Provider:   "openai",
+		Model:      "gpt-4o-mini",
+		Path:       "chat/completions",
+		StatusCode: 200,
+		Success:    true,
+		Cached:     true,
+		Duration:   12,
+		TokensIn:   25,
+		TokensOut:  100,
+		Cost:       0.00006,
+	}}
+
+	actual, _, err := client.ListAIGatewayLogs(context.Background(), AccountIdentifier(testAccountID), testAIGatewayID, ListAIGatewayLogsParams{
+		Provider: "openai",
+		Cached:   BoolPtr(true),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,257 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingClientCertificateCSR = errors.New("required certificate signing request missing")
+)
+
+// APIShieldClientCertificateAuthority is the certificate authority that
+// issued a client certificate.
+type APIShieldClientCertificateAuthority struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// APIShieldClientCertificate is a client certificate issued by the
+// Cloudflare managed CA for API Shield mTLS.
+type APIShieldClientCertificate struct {
+	ID                   string                              `json:"id"`
+	Certificate          string                              `json:"certificate"`
+	CertificateAuthority APIShieldClientCertificateAuthority `json:"certificate_authority"`
+	CommonName           string                              `json:"common_name"`
+	Country              string                              `json:"country"`
+	CSR                  string                              `json:"csr"`
+	FingerprintSHA256    string                              `json:"fingerprint_sha256"`
+	Location             string                              `json:"location"`
+	Organization         string                              `json:"organization"`
+	OrganizationalUnit   string                              `json:"organizational_unit"`
+	SerialNumber         string                              `json:"serial_number"`
+	Signature            string                              `json:"signature"`
+	SKI                  string                              `json:"ski"`
+	State                string                              `json:"state"`
+	// Status is one of `active`, `pending_reactivation`,
+	// `pending_revocation` or `revoked`.
+	Status       string     `json:"status"`
+	ValidityDays int        `json:"validity_days"`
+	IssuedOn     *time.Time `json:"issued_on,omitempty"`
+	ExpiresOn    *time.Time `json:"expires_on,omitempty"`
+}
+
+type APIShieldClientCertificateResponse struct {
+	Response
+	Result APIShieldClientCertificate `json:"result"`
+}
+
+type APIShieldClientCertificatesResponse struct {
+	Response
+	Result     []APIShieldClientCertificate `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+type ListAPIShieldClientCertificatesParams struct {
+	// Status limits the results to certificates with the status, e.g.
+	// `active`.
+	Status string `url:"status,omitempty"`
+	PaginationOptions
+}
+
+// CreateAPIShieldClientCertificateParams is the certificate signing request
+// to issue a client certificate for.
+type CreateAPIShieldClientCertificateParams struct {
+	CSR          string `json:"csr"`
+	ValidityDays int    `json:"validity_days,omitempty"`
+}
+
+// APIShieldMTLSHostnameSettings lists the hostnames that require clients to
+// present a certificate issued by the mTLS certificate. An empty
+// MTLSCertificateID refers to the Cloudflare managed CA.
+type APIShieldMTLSHostnameSettings struct {
+	MTLSCertificateID string   `json:"mtls_certificate_id,omitempty" url:"mtls_certificate_id,omitempty"`
+	Hostnames         []string `json:"hostnames" url:"-"`
+}
+
+type APIShieldMTLSHostnameSettingsResponse struct {
+	Response
+	Result APIShieldMTLSHostnameSettings `json:"result"`
+}
+
+// ListAPIShieldClientCertificates returns the client certificates issued for
+// the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-list-client-certificates
+func (api *API) ListAPIShieldClientCertificates(ctx context.Context, rc *ResourceContainer, params ListAPIShieldClientCertificatesParams) ([]APIShieldClientCertificate, *ResultInfo, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []APIShieldClientCertificate{}, &ResultInfo{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []APIShieldClientCertificate{}, &ResultInfo{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/client_certificates", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []APIShieldClientCertificate{}, &ResultInfo{}, err
+	}
+
+	var r APIShieldClientCertificatesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []APIShieldClientCertificate{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, &r.ResultInfo, nil
+}
+
+// GetAPIShieldClientCertificate returns a client certificate, including its
+// status and validity window.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-client-certificate-details
+func (api *API) GetAPIShieldClientCertificate(ctx context.Context, rc *ResourceContainer, certificateID string) (APIShieldClientCertificate, error) {
+	if rc.Level != ZoneRouteLevel {
+		return APIShieldClientCertificate{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return APIShieldClientCertificate{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return APIShieldClientCertificate{}, ErrMissingCertificateID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/client_certificates/%s", rc.Identifier, certificateID)
+	return api.apiShieldClientCertificateRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateAPIShieldClientCertificate issues a client certificate from the
+// Cloudflare managed CA for the certificate signing request.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-create-client-certificate
+func (api *API) CreateAPIShieldClientCertificate(ctx context.Context, rc *ResourceContainer, params CreateAPIShieldClientCertificateParams) (APIShieldClientCertificate, error) {
+	if rc.Level != ZoneRouteLevel {
+		return APIShieldClientCertificate{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return APIShieldClientCertificate{}, ErrMissingZoneID
+	}
+
+	if params.CSR == "" {
+		return APIShieldClientCertificate{}, ErrMissingClientCertificateCSR
+	}
+
+	uri := fmt.Sprintf("/zones/%s/client_certificates", rc.Identifier)
+	return api.apiShieldClientCertificateRequest(ctx, http.MethodPost, uri, params)
+}
+
+// RevokeAPIShieldClientCertificate revokes a client certificate. The returned
+// certificate has the status `pending_revocation` until the revocation has
+// propagated.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-delete-client-certificate
+func (api *API) RevokeAPIShieldClientCertificate(ctx context.Context, rc *ResourceContainer, certificateID string) (APIShieldClientCertificate, error) {
+	if rc.Level != ZoneRouteLevel {
+		return APIShieldClientCertificate{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return APIShieldClientCertificate{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return APIShieldClientCertificate{}, ErrMissingCertificateID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/client_certificates/%s", rc.Identifier, certificateID)
+	return api.apiShieldClientCertificateRequest(ctx, http.MethodDelete, uri, nil)
+}
+
+func (api *API) apiShieldClientCertificateRequest(ctx context.Context, method, uri string, params interface{}) (APIShieldClientCertificate, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return APIShieldClientCertificate{}, err
+	}
+
+	var r APIShieldClientCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldClientCertificate{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetAPIShieldMTLSHostnameSettings returns the hostnames that enforce client
+// certificates issued by the mTLS certificate.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-list-hostname-associations
+func (api *API) GetAPIShieldMTLSHostnameSettings(ctx context.Context, rc *ResourceContainer, mtlsCertificateID string) (APIShieldMTLSHostnameSettings, error) {
+	if rc.Level != ZoneRouteLevel {
+		return APIShieldMTLSHostnameSettings{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return APIShieldMTLSHostnameSettings{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/certificate_authorities/hostname_associations", rc.Identifier), APIShieldMTLSHostnameSettings{MTLSCertificateID: mtlsCertificateID})
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return APIShieldMTLSHostnameSettings{}, err
+	}
+
+	var r APIShieldMTLSHostnameSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldMTLSHostnameSettings{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	r.Result.MTLSCertificateID = mtlsCertificateID
+
+	return r.Result, nil
+}
+
+// UpdateAPIShieldMTLSHostnameSettings replaces the hostnames that enforce
+// client certificates issued by the mTLS certificate. Passing no hostnames
+// stops enforcement for all of them.
+//
+// API reference: https://developers.cloudflare.com/api/operations/client-certificate-for-a-zone-put-hostname-associations
+func (api *API) UpdateAPIShieldMTLSHostnameSettings(ctx context.Context, rc *ResourceContainer, params APIShieldMTLSHostnameSettings) (APIShieldMTLSHostnameSettings, error) {
+	if rc.Level != ZoneRouteLevel {
+		return APIShieldMTLSHostnameSettings{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return APIShieldMTLSHostnameSettings{}, ErrMissingZoneID
+	}
+
+	if params.Hostnames == nil {
+		params.Hostnames = []string{}
+	}
+
+	uri := fmt.Sprintf("/zones/%s/certificate_authorities/hostname_associations", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return APIShieldMTLSHostnameSettings{}, err
+	}
+
+	var r APIShieldMTLSHostnameSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldMTLSHostnameSettings{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	r.Result.MTLSCertificateID = params.MTLSCertificateID
+
+	return r.Result, nil
+}
@@ -0,0 +1,145 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAPIShieldClientCertificateJSON = `{
+  "id": "b2909ec7-0f0e-4c4b-b7a5-2f0e2b3c4d5e",
+  "certificate": "-----BEGIN CERTIFICATE-----\nMIIDmDCCAoCgAwIBAgIUKTOAZNj\n-----END CERTIFICATE-----\n",
+  "certificate_authority": {"id": "568b6b74-7b0c-4755-8840-4e3b8c24adeb", "name": "Cloudflare Managed CA for account"},
+  "common_name": "partner.example.com",
+  "country": "US",
+  "csr": "-----BEGIN CERTIFICATE REQUEST-----\nMIICY\n-----END CERTIFICATE REQUEST-----\n",
+  "fingerprint_sha256": "256c24690243359fb8cf139a125bd05ebf1d968b71e4caf330718e9f5c8a89ea",
+  "serial_number": "3bb94ff144ac567b9f75ad664b6c55f8d5e48182",
+  "signature": "SHA256WithRSA",
+  "status": "active",
+  "validity_days": 365,
+  "issued_on": "2023-02-23T23:18:00Z",
+  "expires_on": "2024-02-23T23:18:00Z"
+}`
+
+func testAPIShieldClientCertificate() APIShieldClientCertificate {
+	issuedOn := time.Date(2023, 2, 23, 23, 18, 0, 0, time.UTC)
+	expiresOn := time.Date(2024, 2, 23, 23, 18, 0, 0, time.UTC)
+
+	return APIShieldClientCertificate{
+		ID:          "b2909ec7-0f0e-4c4b-b7a5-2f0e2b3c4d5e",
+		Certificate: "-----BEGIN CERTIFICATE-----\nMIIDmDCCAoCgAwIBAgIUKTOAZNj\n-----END CERTIFICATE-----\n",
+		CertificateAuthority: APIShieldClientCertificateAuthority{
+			ID:   "568b6b74-7b0c-4755-8840-4e3b8c24adeb",
+			Name: "Cloudflare Managed CA for account",
+		},
+		CommonName:        "partner.example.com",
+		Country:           "US",
+		CSR:               "-----BEGIN CERTIFICATE REQUEST-----\nMIICY\n-----END CERTIFICATE REQUEST-----\n",
+		FingerprintSHA256: "256c24690243359fb8cf139a125bd05ebf1d968b71e4caf330718e9f5c8a89ea",
+		SerialNumber:      "3bb94ff144ac567b9f75ad664b6c55f8d5e48182",
+		Signature:         "SHA256WithRSA",
+		Status:            "active",
+		ValidityDays:      365,
+		IssuedOn:          &issuedOn,
+		ExpiresOn:         &expiresOn,
+	}
+}
+
+func TestListAPIShieldClientCertificates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s], "result_info": {"page": 1, "per_page": 20, "count": 1, "total_count": 1}}`, testAPIShieldClientCertificateJSON)
+	})
+
+	actual, resultInfo, err := client.ListAPIShieldClientCertificates(context.Background(), ZoneIdentifier(testZoneID), ListAPIShieldClientCertificatesParams{Status: "active"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []APIShieldClientCertificate{testAPIShieldClientCertificate()}, actual)
+		assert.Equal(t, 1, resultInfo.Total)
+	}
+}
+
+func TestCreateAPIShieldClientCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"csr": "-----BEGIN CERTIFICATE REQUEST-----\nMIICY\n-----END CERTIFICATE REQUEST-----\n", "validity_days": 365}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testAPIShieldClientCertificateJSON)
+	})
+
+	_, err := client.CreateAPIShieldClientCertificate(context.Background(), ZoneIdentifier(testZoneID), CreateAPIShieldClientCertificateParams{})
+	assert.ErrorIs(t, err, ErrMissingClientCertificateCSR)
+
+	actual, err := client.CreateAPIShieldClientCertificate(context.Background(), ZoneIdentifier(testZoneID), CreateAPIShieldClientCertificateParams{
+		CSR:          "-----BEGIN CERTIFICATE REQUEST-----\nMIICY\n-----END CERTIFICATE REQUEST-----\n",
+		ValidityDays: 365,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAPIShieldClientCertificate(), actual)
+	}
+}
+
+func TestRevokeAPIShieldClientCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates/b2909ec7-0f0e-4c4b-b7a5-2f0e2b3c4d5e", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "b2909ec7-0f0e-4c4b-b7a5-2f0e2b3c4d5e", "status": "pending_revocation"}}`)
+	})
+
+	_, err := client.RevokeAPIShieldClientCertificate(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingCertificateID)
+
+	actual, err := client.RevokeAPIShieldClientCertificate(context.Background(), ZoneIdentifier(testZoneID), "b2909ec7-0f0e-4c4b-b7a5-2f0e2b3c4d5e")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "pending_revocation", actual.Status)
+	}
+}
+
+func TestAPIShieldMTLSHostnameSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/certificate_authorities/hostname_associations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"mtls_certificate_id": "b2134436-2555-4acf-be5b-26c48136575e", "hostnames": ["partner.example.com"]}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+			assert.Equal(t, "b2134436-2555-4acf-be5b-26c48136575e", r.URL.Query().Get("mtls_certificate_id"))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"hostnames": ["partner.example.com"]}}`)
+	})
+
+	want := APIShieldMTLSHostnameSettings{
+		MTLSCertificateID: "b2134436-2555-4acf-be5b-26c48136575e",
+		Hostnames:         []string{"partner.example.com"},
+	}
+
+	actual, err := client.GetAPIShieldMTLSHostnameSettings(context.Background(), ZoneIdentifier(testZoneID), "b2134436-2555-4acf-be5b-26c48136575e")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	actual, err = client.UpdateAPIShieldMTLSHostnameSettings(context.Background(), ZoneIdentifier(testZoneID), want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
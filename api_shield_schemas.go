@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -336,6 +337,32 @@ func (api *API) UpdateAPIShieldSchema(ctx context.Context, rc *ResourceContainer
 
 // Schema Validation Settings
 
+// Mitigation actions of API Shield Schema Validation.
+const (
+	APIShieldSchemaValidationMitigationActionNone  = "none"
+	APIShieldSchemaValidationMitigationActionLog   = "log"
+	APIShieldSchemaValidationMitigationActionBlock = "block"
+)
+
+var ErrInvalidAPIShieldMitigationAction = errors.New("mitigation action must be none, log or block")
+
+// validateAPIShieldMitigationAction accepts a nil action, which resets it, or
+// one of the known mitigation actions.
+func validateAPIShieldMitigationAction(action *string) error {
+	if action == nil {
+		return nil
+	}
+
+	switch *action {
+	case APIShieldSchemaValidationMitigationActionNone,
+		APIShieldSchemaValidationMitigationActionLog,
+		APIShieldSchemaValidationMitigationActionBlock:
+		return nil
+	}
+
+	return ErrInvalidAPIShieldMitigationAction
+}
+
 // APIShieldSchemaValidationSettings represents zone level schema validation settings for
 // API Shield Schema Validation 2.0.
 type APIShieldSchemaValidationSettings struct {
@@ -397,6 +424,10 @@ func (api *API) GetAPIShieldSchemaValidationSettings(ctx context.Context, rc *Re
 //
 // API documentation: https://developers.cloudflare.com/api/operations/api-shield-schema-validation-patch-zone-level-settings
 func (api *API) UpdateAPIShieldSchemaValidationSettings(ctx context.Context, rc *ResourceContainer, params UpdateAPIShieldSchemaValidationSettingsParams) (*APIShieldSchemaValidationSettings, error) {
+	if err := validateAPIShieldMitigationAction(params.DefaultMitigationAction); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/zones/%s/api_gateway/settings/schema_validation", rc.Identifier)
 
 	uri := buildURI(path, params)
@@ -468,7 +499,7 @@ func (api *API) GetAPIShieldOperationSchemaValidationSettings(ctx context.Contex
 
 	uri := buildURI(path, nil)
 
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -486,6 +517,12 @@ func (api *API) GetAPIShieldOperationSchemaValidationSettings(ctx context.Contex
 //
 // API documentation: https://developers.cloudflare.com/api/operations/api-shield-schema-validation-update-multiple-operation-level-settings
 func (api *API) UpdateAPIShieldOperationSchemaValidationSettings(ctx context.Context, rc *ResourceContainer, params UpdateAPIShieldOperationSchemaValidationSettings) (*UpdateAPIShieldOperationSchemaValidationSettings, error) {
+	for operationID, settings := range params {
+		if err := validateAPIShieldMitigationAction(settings.MitigationAction); err != nil {
+			return nil, fmt.Errorf("operation %s: %w", operationID, err)
+		}
+	}
+
 	path := fmt.Sprintf("/zones/%s/api_gateway/operations/schema_validation", rc.Identifier)
 
 	uri := buildURI(path, nil)
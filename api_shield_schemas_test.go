@@ -597,6 +597,9 @@ func TestGetAPIShieldOperationSchemaValidationSettings(t *testing.T) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
 		require.Empty(t, r.URL.Query())
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Empty(t, body)
 		w.Header().Set("content-type", "application/json")
 		fmt.Fprint(w, response)
 	}
@@ -667,3 +670,26 @@ func TestUpdateAPIShieldOperationSchemaValidationSettings(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestUpdateAPIShieldSchemaValidationSettingsInvalidMitigationAction(t *testing.T) {
+	setup()
+	t.Cleanup(teardown)
+
+	challenge := "challenge"
+
+	_, err := client.UpdateAPIShieldSchemaValidationSettings(
+		context.Background(),
+		ZoneIdentifier(testZoneID),
+		UpdateAPIShieldSchemaValidationSettingsParams{DefaultMitigationAction: &challenge},
+	)
+	require.ErrorIs(t, err, ErrInvalidAPIShieldMitigationAction)
+
+	_, err = client.UpdateAPIShieldOperationSchemaValidationSettings(
+		context.Background(),
+		ZoneIdentifier(testZoneID),
+		UpdateAPIShieldOperationSchemaValidationSettings{
+			testAPIShieldOperationId: APIShieldOperationSchemaValidationSettings{MitigationAction: &challenge},
+		},
+	)
+	require.ErrorIs(t, err, ErrInvalidAPIShieldMitigationAction)
+}
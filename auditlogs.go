@@ -63,6 +63,7 @@ type AuditLogFilter struct {
 	ID           string
 	ActorIP      string
 	ActorEmail   string
+	ActionType   string
 	HideUserLogs bool
 	Direction    string
 	ZoneName     string
@@ -87,6 +88,9 @@ func (a AuditLogFilter) ToQuery() url.Values {
 	if a.ActorEmail != "" {
 		v.Add("actor.email", a.ActorEmail)
 	}
+	if a.ActionType != "" {
+		v.Add("action.type", a.ActionType)
+	}
 	if a.HideUserLogs {
 		v.Add("hide_user_logs", "true")
 	}
@@ -130,6 +134,53 @@ func (api *API) GetOrganizationAuditLogs(ctx context.Context, organizationID str
 	return unmarshalReturn(res)
 }
 
+// AuditLogs returns the audit logs of an account, filtered by the
+// AuditLogFilter. All pages are fetched unless a specific page is requested
+// via `Page`.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/audit-logs-get-account-audit-logs
+func (api *API) AuditLogs(ctx context.Context, accountID string, a AuditLogFilter) ([]AuditLog, error) {
+	if accountID == "" {
+		return []AuditLog{}, ErrMissingAccountID
+	}
+
+	autoPaginate := a.Page < 1
+	if a.Page < 1 {
+		a.Page = 1
+	}
+
+	if a.PerPage < 1 {
+		a.PerPage = 100
+	}
+
+	var logs []AuditLog
+	for {
+		r, err := api.GetOrganizationAuditLogs(ctx, accountID, a)
+		if err != nil {
+			return []AuditLog{}, err
+		}
+
+		logs = append(logs, r.Result...)
+		if !autoPaginate || len(r.Result) == 0 {
+			break
+		}
+
+		// The audit log endpoint doesn't always report the total count so fall
+		// back to checking for a full page when it isn't present.
+		if r.ResultInfo.getTotalPages() > 0 {
+			if !r.ResultInfo.HasMorePages() {
+				break
+			}
+		} else if len(r.Result) < a.PerPage {
+			break
+		}
+
+		a.Page++
+	}
+
+	return logs, nil
+}
+
 // unmarshalReturn will unmarshal bytes and return an auditlogresponse.
 func unmarshalReturn(res []byte) (AuditLogResponse, error) {
 	var auditResponse AuditLogResponse
@@ -1,8 +1,14 @@
 package cloudflare
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAuditLogFilterToQuery(t *testing.T) {
@@ -18,6 +24,11 @@ func TestAuditLogFilterToQuery(t *testing.T) {
 		t.Fatalf("Did not properly stringify the actor.email field: %s", filter.ToQuery().Encode())
 	}
 
+	filter.ActionType = "update"
+	if !strings.Contains(filter.ToQuery().Encode(), "action.type=update") {
+		t.Fatalf("Did not properly stringify the action.type field: %s", filter.ToQuery().Encode())
+	}
+
 	filter.HideUserLogs = true
 	if !strings.Contains(filter.ToQuery().Encode(), "hide_user_logs=true") {
 		t.Fatalf("Did not properly stringify the hide_user_logs field: %s", filter.ToQuery().Encode())
@@ -58,3 +69,75 @@ func TestAuditLogFilterToQuery(t *testing.T) {
 		t.Fatalf("Did not properly stringify the page field: %s", filter.ToQuery().Encode())
 	}
 }
+
+func TestAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "user@example.com", r.URL.Query().Get("actor.email"))
+		assert.Equal(t, "update", r.URL.Query().Get("action.type"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "d5b0f326-1232-4452-8858-1089bd7168ef",
+      "action": {"result": true, "type": "update"},
+      "actor": {"email": "user@example.com", "id": "f6b5de0326bb5182b8a4840ee01ec774", "ip": "198.41.129.166", "type": "user"},
+      "metadata": {"name": "security_level"},
+      "owner": {"id": "1b8d3e5c7f9a4b2c6d8e0f1a3b5c7d9e"},
+      "resource": {"id": "4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a", "type": "zone"},
+      "when": "2023-11-01T12:00:00Z"
+    }
+  ],
+  "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}
+}`)
+		case "2":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "e2a8d3f4-5b6c-4d7e-8f9a-0b1c2d3e4f5a",
+      "action": {"result": true, "type": "update"},
+      "actor": {"email": "user@example.com", "id": "f6b5de0326bb5182b8a4840ee01ec774", "ip": "198.41.129.166", "type": "user"},
+      "owner": {"id": "1b8d3e5c7f9a4b2c6d8e0f1a3b5c7d9e"},
+      "resource": {"id": "4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a", "type": "zone"},
+      "when": "2023-11-02T12:00:00Z"
+    }
+  ],
+  "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}
+}`)
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.Query().Get("page"))
+		}
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/audit_logs", handler)
+
+	actual, err := client.AuditLogs(context.Background(), testAccountID, AuditLogFilter{
+		ActorEmail: "user@example.com",
+		ActionType: "update",
+		PerPage:    1,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 2)
+		assert.Equal(t, "d5b0f326-1232-4452-8858-1089bd7168ef", actual[0].ID)
+		assert.Equal(t, "security_level", actual[0].Metadata["name"])
+		assert.Equal(t, AuditLogActor{Email: "user@example.com", ID: "f6b5de0326bb5182b8a4840ee01ec774", IP: "198.41.129.166", Type: "user"}, actual[0].Actor)
+		assert.Equal(t, "e2a8d3f4-5b6c-4d7e-8f9a-0b1c2d3e4f5a", actual[1].ID)
+		assert.Equal(t, time.Date(2023, 11, 2, 12, 0, 0, 0, time.UTC), actual[1].When)
+	}
+
+	_, err = client.AuditLogs(context.Background(), "", AuditLogFilter{})
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+}
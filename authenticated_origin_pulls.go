@@ -27,6 +27,10 @@ type AuthenticatedOriginPullsResponse struct {
 //
 // API reference: https://api.cloudflare.com/#zone-settings-get-tls-client-auth-setting
 func (api *API) GetAuthenticatedOriginPullsStatus(ctx context.Context, zoneID string) (AuthenticatedOriginPulls, error) {
+	if zoneID == "" {
+		return AuthenticatedOriginPulls{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/settings/tls_client_auth", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -43,6 +47,10 @@ func (api *API) GetAuthenticatedOriginPullsStatus(ctx context.Context, zoneID st
 //
 // API reference: https://api.cloudflare.com/#zone-settings-change-tls-client-auth-setting
 func (api *API) SetAuthenticatedOriginPullsStatus(ctx context.Context, zoneID string, enable bool) (AuthenticatedOriginPulls, error) {
+	if zoneID == "" {
+		return AuthenticatedOriginPulls{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/settings/tls_client_auth", zoneID)
 	var val string
 	if enable {
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,11 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var (
+	ErrMissingAuthenticatedOriginPullsCertificate = errors.New("required certificate missing")
+	ErrMissingAuthenticatedOriginPullsPrivateKey  = errors.New("required private key missing")
+)
+
 // PerHostnameAuthenticatedOriginPullsCertificateDetails represents the metadata for a Per Hostname AuthenticatedOriginPulls certificate.
 type PerHostnameAuthenticatedOriginPullsCertificateDetails struct {
 	ID           string    `json:"id"`
@@ -79,6 +85,10 @@ type PerHostnameAuthenticatedOriginPullsConfigParams struct {
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-list-certificates
 func (api *API) ListPerHostnameAuthenticatedOriginPullsCertificates(ctx context.Context, zoneID string) ([]PerHostnameAuthenticatedOriginPullsDetails, error) {
+	if zoneID == "" {
+		return []PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames/certificates", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -95,6 +105,18 @@ func (api *API) ListPerHostnameAuthenticatedOriginPullsCertificates(ctx context.
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-upload-a-hostname-client-certificate
 func (api *API) UploadPerHostnameAuthenticatedOriginPullsCertificate(ctx context.Context, zoneID string, params PerHostnameAuthenticatedOriginPullsCertificateParams) (PerHostnameAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if params.Certificate == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingAuthenticatedOriginPullsCertificate
+	}
+
+	if params.PrivateKey == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingAuthenticatedOriginPullsPrivateKey
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames/certificates", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -111,6 +133,14 @@ func (api *API) UploadPerHostnameAuthenticatedOriginPullsCertificate(ctx context
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-get-the-hostname-client-certificate
 func (api *API) GetPerHostnameAuthenticatedOriginPullsCertificate(ctx context.Context, zoneID, certificateID string) (PerHostnameAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingCertificateID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames/certificates/%s", zoneID, certificateID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -127,6 +157,14 @@ func (api *API) GetPerHostnameAuthenticatedOriginPullsCertificate(ctx context.Co
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-delete-hostname-client-certificate
 func (api *API) DeletePerHostnameAuthenticatedOriginPullsCertificate(ctx context.Context, zoneID, certificateID string) (PerHostnameAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return PerHostnameAuthenticatedOriginPullsCertificateDetails{}, ErrMissingCertificateID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames/certificates/%s", zoneID, certificateID)
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -143,6 +181,20 @@ func (api *API) DeletePerHostnameAuthenticatedOriginPullsCertificate(ctx context
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-enable-or-disable-a-hostname-for-client-authentication
 func (api *API) EditPerHostnameAuthenticatedOriginPullsConfig(ctx context.Context, zoneID string, config []PerHostnameAuthenticatedOriginPullsConfig) ([]PerHostnameAuthenticatedOriginPullsDetails, error) {
+	if zoneID == "" {
+		return []PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingZoneID
+	}
+
+	for _, c := range config {
+		if c.Hostname == "" {
+			return []PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingHostname
+		}
+
+		if c.Enabled && c.CertID == "" {
+			return []PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingCertificateID
+		}
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames", zoneID)
 	conf := PerHostnameAuthenticatedOriginPullsConfigParams{
 		Config: config,
@@ -162,6 +214,14 @@ func (api *API) EditPerHostnameAuthenticatedOriginPullsConfig(ctx context.Contex
 //
 // API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-get-the-hostname-status-for-client-authentication
 func (api *API) GetPerHostnameAuthenticatedOriginPullsConfig(ctx context.Context, zoneID, hostname string) (PerHostnameAuthenticatedOriginPullsDetails, error) {
+	if zoneID == "" {
+		return PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingZoneID
+	}
+
+	if hostname == "" {
+		return PerHostnameAuthenticatedOriginPullsDetails{}, ErrMissingHostname
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/hostnames/%s", zoneID, hostname)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
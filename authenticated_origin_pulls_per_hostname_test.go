@@ -358,3 +358,29 @@ func TestGetPerHostnameAuthenticatedOriginPullsConfig(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestPerHostnameAuthenticatedOriginPullsValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.ListPerHostnameAuthenticatedOriginPullsCertificates(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+
+	_, err = client.UploadPerHostnameAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, PerHostnameAuthenticatedOriginPullsCertificateParams{PrivateKey: "key"})
+	assert.ErrorIs(t, err, ErrMissingAuthenticatedOriginPullsCertificate)
+
+	_, err = client.UploadPerHostnameAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, PerHostnameAuthenticatedOriginPullsCertificateParams{Certificate: "cert"})
+	assert.ErrorIs(t, err, ErrMissingAuthenticatedOriginPullsPrivateKey)
+
+	_, err = client.DeletePerHostnameAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, "")
+	assert.ErrorIs(t, err, ErrMissingCertificateID)
+
+	_, err = client.EditPerHostnameAuthenticatedOriginPullsConfig(context.Background(), testZoneID, []PerHostnameAuthenticatedOriginPullsConfig{{CertID: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60", Enabled: true}})
+	assert.ErrorIs(t, err, ErrMissingHostname)
+
+	_, err = client.EditPerHostnameAuthenticatedOriginPullsConfig(context.Background(), testZoneID, []PerHostnameAuthenticatedOriginPullsConfig{{Hostname: "app.example.com", Enabled: true}})
+	assert.ErrorIs(t, err, ErrMissingCertificateID)
+
+	_, err = client.GetPerHostnameAuthenticatedOriginPullsConfig(context.Background(), testZoneID, "")
+	assert.ErrorIs(t, err, ErrMissingHostname)
+}
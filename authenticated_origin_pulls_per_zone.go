@@ -53,6 +53,10 @@ type PerZoneAuthenticatedOriginPullsCertificateParams struct {
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-get-enablement-setting-for-zone
 func (api *API) GetPerZoneAuthenticatedOriginPullsStatus(ctx context.Context, zoneID string) (PerZoneAuthenticatedOriginPullsSettings, error) {
+	if zoneID == "" {
+		return PerZoneAuthenticatedOriginPullsSettings{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/settings", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -69,6 +73,10 @@ func (api *API) GetPerZoneAuthenticatedOriginPullsStatus(ctx context.Context, zo
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-set-enablement-for-zone
 func (api *API) SetPerZoneAuthenticatedOriginPullsStatus(ctx context.Context, zoneID string, enable bool) (PerZoneAuthenticatedOriginPullsSettings, error) {
+	if zoneID == "" {
+		return PerZoneAuthenticatedOriginPullsSettings{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/settings", zoneID)
 	params := struct {
 		Enabled bool `json:"enabled"`
@@ -90,6 +98,18 @@ func (api *API) SetPerZoneAuthenticatedOriginPullsStatus(ctx context.Context, zo
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-upload-certificate
 func (api *API) UploadPerZoneAuthenticatedOriginPullsCertificate(ctx context.Context, zoneID string, params PerZoneAuthenticatedOriginPullsCertificateParams) (PerZoneAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if params.Certificate == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingAuthenticatedOriginPullsCertificate
+	}
+
+	if params.PrivateKey == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingAuthenticatedOriginPullsPrivateKey
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -106,6 +126,10 @@ func (api *API) UploadPerZoneAuthenticatedOriginPullsCertificate(ctx context.Con
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-list-certificates
 func (api *API) ListPerZoneAuthenticatedOriginPullsCertificates(ctx context.Context, zoneID string) ([]PerZoneAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return []PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -122,6 +146,14 @@ func (api *API) ListPerZoneAuthenticatedOriginPullsCertificates(ctx context.Cont
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-get-certificate-details
 func (api *API) GetPerZoneAuthenticatedOriginPullsCertificateDetails(ctx context.Context, zoneID, certificateID string) (PerZoneAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingCertificateID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/%s", zoneID, certificateID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -138,6 +170,14 @@ func (api *API) GetPerZoneAuthenticatedOriginPullsCertificateDetails(ctx context
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-delete-certificate
 func (api *API) DeletePerZoneAuthenticatedOriginPullsCertificate(ctx context.Context, zoneID, certificateID string) (PerZoneAuthenticatedOriginPullsCertificateDetails, error) {
+	if zoneID == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingZoneID
+	}
+
+	if certificateID == "" {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, ErrMissingCertificateID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/origin_tls_client_auth/%s", zoneID, certificateID)
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -228,3 +228,23 @@ func TestDeletePerZoneAuthenticatedOriginPullsCertificate(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestPerZoneAuthenticatedOriginPullsValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.GetAuthenticatedOriginPullsStatus(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+
+	_, err = client.SetPerZoneAuthenticatedOriginPullsStatus(context.Background(), "", true)
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+
+	_, err = client.UploadPerZoneAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, PerZoneAuthenticatedOriginPullsCertificateParams{PrivateKey: "key"})
+	assert.ErrorIs(t, err, ErrMissingAuthenticatedOriginPullsCertificate)
+
+	_, err = client.UploadPerZoneAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, PerZoneAuthenticatedOriginPullsCertificateParams{Certificate: "cert"})
+	assert.ErrorIs(t, err, ErrMissingAuthenticatedOriginPullsPrivateKey)
+
+	_, err = client.DeletePerZoneAuthenticatedOriginPullsCertificate(context.Background(), testZoneID, "")
+	assert.ErrorIs(t, err, ErrMissingCertificateID)
+}
@@ -0,0 +1,279 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingCallsAppID     = errors.New("required Calls app ID missing")
+	ErrMissingCallsTURNKeyID = errors.New("required Calls TURN key ID missing")
+)
+
+// CallsApp is a Cloudflare Calls application, used to connect WebRTC clients
+// through the Calls SFU.
+type CallsApp struct {
+	UID      string     `json:"uid"`
+	Name     string     `json:"name"`
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+	// SecretKey is only returned when the app is created.
+	SecretKey string `json:"secret,omitempty"`
+}
+
+type CallsAppParams struct {
+	Name string `json:"name,omitempty"`
+}
+
+// CallsTURNKey is a key for generating credentials for the Cloudflare TURN
+// service.
+type CallsTURNKey struct {
+	UID      string     `json:"uid"`
+	Name     string     `json:"name"`
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+	// Key is the bearer token used to generate short-lived TURN
+	// credentials. It is only returned when the key is created.
+	Key string `json:"key,omitempty"`
+}
+
+type CallsTURNKeyParams struct {
+	Name string `json:"name,omitempty"`
+}
+
+type CallsAppResponse struct {
+	Result CallsApp `json:"result"`
+	Response
+}
+
+type ListCallsAppsResponse struct {
+	Result []CallsApp `json:"result"`
+	Response
+}
+
+type CallsTURNKeyResponse struct {
+	Result CallsTURNKey `json:"result"`
+	Response
+}
+
+type ListCallsTURNKeysResponse struct {
+	Result []CallsTURNKey `json:"result"`
+	Response
+}
+
+// ListCallsApps returns the Calls apps of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-apps-list-calls-apps
+func (api *API) ListCallsApps(ctx context.Context, rc *ResourceContainer) ([]CallsApp, error) {
+	if rc.Level != AccountRouteLevel {
+		return []CallsApp{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []CallsApp{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/apps", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []CallsApp{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r ListCallsAppsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CallsApp{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// CreateCallsApp creates a Calls app. The returned app includes the secret
+// key used to authenticate requests to the Calls API, which cannot be
+// retrieved again.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-apps-create-a-new-app
+func (api *API) CreateCallsApp(ctx context.Context, rc *ResourceContainer, params CallsAppParams) (CallsApp, error) {
+	if rc.Level != AccountRouteLevel {
+		return CallsApp{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return CallsApp{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/apps", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return CallsApp{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalCallsApp(res)
+}
+
+// GetCallsApp returns a single Calls app.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-apps-retrieve-app-details
+func (api *API) GetCallsApp(ctx context.Context, rc *ResourceContainer, appID string) (CallsApp, error) {
+	if rc.Level != AccountRouteLevel {
+		return CallsApp{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return CallsApp{}, ErrMissingAccountID
+	}
+
+	if appID == "" {
+		return CallsApp{}, ErrMissingCallsAppID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/apps/%s", rc.Identifier, appID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return CallsApp{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalCallsApp(res)
+}
+
+// UpdateCallsApp renames a Calls app.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-apps-update-app-details
+func (api *API) UpdateCallsApp(ctx context.Context, rc *ResourceContainer, appID string, params CallsAppParams) (CallsApp, error) {
+	if rc.Level != AccountRouteLevel {
+		return CallsApp{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return CallsApp{}, ErrMissingAccountID
+	}
+
+	if appID == "" {
+		return CallsApp{}, ErrMissingCallsAppID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/apps/%s", rc.Identifier, appID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return CallsApp{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalCallsApp(res)
+}
+
+// DeleteCallsApp deletes a Calls app.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-apps-delete-app
+func (api *API) DeleteCallsApp(ctx context.Context, rc *ResourceContainer, appID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if appID == "" {
+		return ErrMissingCallsAppID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/apps/%s", rc.Identifier, appID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return nil
+}
+
+// ListCallsTURNKeys returns the TURN keys of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-turn-key-list
+func (api *API) ListCallsTURNKeys(ctx context.Context, rc *ResourceContainer) ([]CallsTURNKey, error) {
+	if rc.Level != AccountRouteLevel {
+		return []CallsTURNKey{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []CallsTURNKey{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/turn_keys", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []CallsTURNKey{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r ListCallsTURNKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CallsTURNKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// CreateCallsTURNKey creates a TURN key. The returned key includes the bearer
+// token used to generate TURN credentials, which cannot be retrieved again.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-turn-key-create
+func (api *API) CreateCallsTURNKey(ctx context.Context, rc *ResourceContainer, params CallsTURNKeyParams) (CallsTURNKey, error) {
+	if rc.Level != AccountRouteLevel {
+		return CallsTURNKey{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return CallsTURNKey{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/turn_keys", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return CallsTURNKey{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r CallsTURNKeyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CallsTURNKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// DeleteCallsTURNKey deletes a TURN key. Credentials generated with it stop
+// working.
+//
+// API reference: https://developers.cloudflare.com/api/operations/calls-delete-turn-key
+func (api *API) DeleteCallsTURNKey(ctx context.Context, rc *ResourceContainer, keyID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if keyID == "" {
+		return ErrMissingCallsTURNKeyID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/calls/turn_keys/%s", rc.Identifier, keyID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return nil
+}
+
+func unmarshalCallsApp(res []byte) (CallsApp, error) {
+	var r CallsAppResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return CallsApp{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
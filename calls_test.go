@@ -0,0 +1,161 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCallsAppID     = "2a95132c15732412d22c1476fa83f27a"
+	testCallsTURNKeyID = "4b95132c15732412d22c1476fa83f27a"
+)
+
+func TestCreateCallsApp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "production"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "uid": "%s",
+    "name": "production",
+    "created": "2024-07-01T08:00:00Z",
+    "modified": "2024-07-01T08:00:00Z",
+    "secret": "66bcf64aa8907b9f9d90ac17746a77ce394c393b92b3916633dc02846e608ad4"
+  }
+}`, testCallsAppID)
+	})
+
+	created := time.Date(2024, time.July, 1, 8, 0, 0, 0, time.UTC)
+	want := CallsApp{
+		UID:       testCallsAppID,
+		Name:      "production",
+		Created:   &created,
+		Modified:  &created,
+		SecretKey: "66bcf64aa8907b9f9d90ac17746a77ce394c393b92b3916633dc02846e608ad4",
+	}
+
+	actual, err := client.CreateCallsApp(context.Background(), AccountIdentifier(testAccountID), CallsAppParams{Name: "production"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListCallsApps(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [{"uid": "%s", "name": "production"}]}`, testCallsAppID)
+	})
+
+	actual, err := client.ListCallsApps(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []CallsApp{{UID: testCallsAppID, Name: "production"}}, actual)
+	}
+}
+
+func TestUpdateCallsApp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/apps/"+testCallsAppID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "staging"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"uid": "%s", "name": "staging"}}`, testCallsAppID)
+	})
+
+	_, err := client.UpdateCallsApp(context.Background(), AccountIdentifier(testAccountID), "", CallsAppParams{Name: "staging"})
+	assert.ErrorIs(t, err, ErrMissingCallsAppID)
+
+	actual, err := client.UpdateCallsApp(context.Background(), AccountIdentifier(testAccountID), testCallsAppID, CallsAppParams{Name: "staging"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, CallsApp{UID: testCallsAppID, Name: "staging"}, actual)
+	}
+}
+
+func TestDeleteCallsApp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/apps/"+testCallsAppID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"uid": "%s", "name": "production"}}`, testCallsAppID)
+	})
+
+	err := client.DeleteCallsApp(context.Background(), AccountIdentifier(testAccountID), testCallsAppID)
+	assert.NoError(t, err)
+}
+
+func TestCreateCallsTURNKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/turn_keys", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "production"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "uid": "%s",
+    "name": "production",
+    "key": "a2f5d5e1b0c3e4f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1"
+  }
+}`, testCallsTURNKeyID)
+	})
+
+	want := CallsTURNKey{
+		UID:  testCallsTURNKeyID,
+		Name: "production",
+		Key:  "a2f5d5e1b0c3e4f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1",
+	}
+
+	actual, err := client.CreateCallsTURNKey(context.Background(), AccountIdentifier(testAccountID), CallsTURNKeyParams{Name: "production"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListCallsTURNKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/calls/turn_keys", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [{"uid": "%s", "name": "production"}]}`, testCallsTURNKeyID)
+	})
+
+	actual, err := client.ListCallsTURNKeys(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []CallsTURNKey{{UID: testCallsTURNKeyID, Name: "production"}}, actual)
+	}
+
+	_, err = client.ListCallsTURNKeys(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrInvalidCloudConnectorProvider = errors.New("cloud connector provider must be one of aws_s3, r2, gcp_storage or azure_storage")
+	ErrMissingCloudConnectorHost     = errors.New("cloud connector rule requires a host parameter")
+)
+
+// CloudConnectorProvider is the cloud storage service requests are routed
+// to.
+type CloudConnectorProvider string
+
+const (
+	CloudConnectorProviderAWSS3        CloudConnectorProvider = "aws_s3"
+	CloudConnectorProviderR2           CloudConnectorProvider = "r2"
+	CloudConnectorProviderGCPStorage   CloudConnectorProvider = "gcp_storage"
+	CloudConnectorProviderAzureStorage CloudConnectorProvider = "azure_storage"
+)
+
+// CloudConnectorRuleParameters are the provider specific settings of a
+// Cloud Connector rule.
+type CloudConnectorRuleParameters struct {
+	// Host is the bucket hostname requests are sent to, for example
+	// `examplebucket.s3.eu-north-1.amazonaws.com`.
+	Host string `json:"host"`
+}
+
+// CloudConnectorRule routes requests matching the expression to a cloud
+// storage origin.
+type CloudConnectorRule struct {
+	ID          string                       `json:"id,omitempty"`
+	Enabled     bool                         `json:"enabled"`
+	Expression  string                       `json:"expression"`
+	Description string                       `json:"description,omitempty"`
+	Provider    CloudConnectorProvider       `json:"provider"`
+	Parameters  CloudConnectorRuleParameters `json:"parameters"`
+}
+
+type CloudConnectorRulesResponse struct {
+	Response
+	Result []CloudConnectorRule `json:"result"`
+}
+
+// GetCloudConnectorRules returns the Cloud Connector rules of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-cloud-connector-rules
+func (api *API) GetCloudConnectorRules(ctx context.Context, rc *ResourceContainer) ([]CloudConnectorRule, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []CloudConnectorRule{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []CloudConnectorRule{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/cloud_connector/rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []CloudConnectorRule{}, err
+	}
+
+	var r CloudConnectorRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CloudConnectorRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateCloudConnectorRules replaces all of the Cloud Connector rules of a
+// zone. Rules are evaluated in order.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-cloud-conenctor-rules-put
+func (api *API) UpdateCloudConnectorRules(ctx context.Context, rc *ResourceContainer, rules []CloudConnectorRule) ([]CloudConnectorRule, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []CloudConnectorRule{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []CloudConnectorRule{}, ErrMissingZoneID
+	}
+
+	for _, rule := range rules {
+		switch rule.Provider {
+		case CloudConnectorProviderAWSS3, CloudConnectorProviderR2, CloudConnectorProviderGCPStorage, CloudConnectorProviderAzureStorage:
+		default:
+			return []CloudConnectorRule{}, ErrInvalidCloudConnectorProvider
+		}
+
+		if rule.Parameters.Host == "" {
+			return []CloudConnectorRule{}, ErrMissingCloudConnectorHost
+		}
+	}
+
+	if rules == nil {
+		rules = []CloudConnectorRule{}
+	}
+
+	uri := fmt.Sprintf("/zones/%s/cloud_connector/rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, rules)
+	if err != nil {
+		return []CloudConnectorRule{}, err
+	}
+
+	var r CloudConnectorRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CloudConnectorRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCloudConnectorRulesJSON = `[
+  {
+    "id": "95c365e17e1b46599cd99e5b231fac4e",
+    "enabled": true,
+    "expression": "http.request.uri.path wildcard \"/images/*\"",
+    "description": "route images to S3",
+    "provider": "aws_s3",
+    "parameters": {"host": "examplebucket.s3.eu-north-1.amazonaws.com"}
+  }
+]`
+
+var testCloudConnectorRules = []CloudConnectorRule{{
+	ID:          "95c365e17e1b46599cd99e5b231fac4e",
+	Enabled:     true,
+	Expression:  `http.request.uri.path wildcard "/images/*"`,
+	Description: "route images to S3",
+	Provider:    CloudConnectorProviderAWSS3,
+	Parameters:  CloudConnectorRuleParameters{Host: "examplebucket.s3.eu-north-1.amazonaws.com"},
+}}
+
+func TestGetCloudConnectorRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cloud_connector/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testCloudConnectorRulesJSON)
+	})
+
+	_, err := client.GetCloudConnectorRules(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	actual, err := client.GetCloudConnectorRules(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, testCloudConnectorRules, actual)
+	}
+}
+
+func TestUpdateCloudConnectorRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cloud_connector/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, testCloudConnectorRulesJSON, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testCloudConnectorRulesJSON)
+	})
+
+	_, err := client.UpdateCloudConnectorRules(context.Background(), ZoneIdentifier(testZoneID), []CloudConnectorRule{{
+		Expression: "true",
+		Provider:   "dropbox",
+		Parameters: CloudConnectorRuleParameters{Host: "example.com"},
+	}})
+	assert.ErrorIs(t, err, ErrInvalidCloudConnectorProvider)
+
+	_, err = client.UpdateCloudConnectorRules(context.Background(), ZoneIdentifier(testZoneID), []CloudConnectorRule{{
+		Expression: "true",
+		Provider:   CloudConnectorProviderR2,
+	}})
+	assert.ErrorIs(t, err, ErrMissingCloudConnectorHost)
+
+	actual, err := client.UpdateCloudConnectorRules(context.Background(), ZoneIdentifier(testZoneID), testCloudConnectorRules)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testCloudConnectorRules, actual)
+	}
+}
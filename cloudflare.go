@@ -3,6 +3,7 @@ package cloudflare
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -12,12 +13,12 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/goccy/go-json"
+	"go.opentelemetry.io/otel/trace"
 
 	"golang.org/x/time/rate"
 )
@@ -49,10 +50,21 @@ type API struct {
 	UserAgent         string
 	headers           http.Header
 	httpClient        *http.Client
+	doer              Doer
+	requestTimeout    time.Duration
+	transport         *http.Transport
 	authType          int
 	rateLimiter       *rate.Limiter
 	retryPolicy       RetryPolicy
 	logger            Logger
+	logRequests       bool
+	tracer            trace.Tracer
+	autoIdempotency   bool
+	requestRecorder   RequestRecorderFunc
+	defaultContainer  *ResourceContainer
+	responseStreaming bool
+	zoneCache         *zoneCache
+	accessKeys        *accessKeySetCache
 	Debug             bool
 }
 
@@ -70,7 +82,8 @@ func newClient(opts ...Option) (*API, error) {
 			MinRetryDelay: 1 * time.Second,
 			MaxRetryDelay: 30 * time.Second,
 		},
-		logger: silentLogger,
+		logger:     silentLogger,
+		accessKeys: newAccessKeySetCache(),
 	}
 
 	err := api.parseOptions(opts...)
@@ -79,9 +92,16 @@ func newClient(opts ...Option) (*API, error) {
 	}
 
 	// Fall back to http.DefaultClient if the package user does not provide
-	// their own.
+	// their own or ask for a tuned one.
 	if api.httpClient == nil {
-		api.httpClient = http.DefaultClient
+		if api.requestTimeout > 0 || api.transport != nil {
+			api.httpClient = &http.Client{Timeout: api.requestTimeout}
+			if api.transport != nil {
+				api.httpClient.Transport = api.transport
+			}
+		} else {
+			api.httpClient = http.DefaultClient
+		}
 	}
 
 	return api, nil
@@ -144,9 +164,16 @@ func (api *API) SetAuthType(authType int) {
 	api.authType = authType
 }
 
-// ZoneIDByName retrieves a zone's ID from the name.
+// ZoneIDByName retrieves a zone's ID from the name. With `WithZoneCache` the
+// ID is only looked up once per cache TTL.
 func (api *API) ZoneIDByName(zoneName string) (string, error) {
 	zoneName = normalizeZoneName(zoneName)
+	if api.zoneCache != nil {
+		if id, ok := api.zoneCache.get(zoneName); ok {
+			return id, nil
+		}
+	}
+
 	res, err := api.ListZonesContext(context.Background(), WithZoneFilters(zoneName, "", ""))
 	if err != nil {
 		return "", fmt.Errorf("ListZonesContext command failed: %w", err)
@@ -156,6 +183,9 @@ func (api *API) ZoneIDByName(zoneName string) (string, error) {
 	case 0:
 		return "", errors.New("zone could not be found")
 	case 1:
+		if api.zoneCache != nil {
+			api.zoneCache.set(zoneName, res.Result[0].ID)
+		}
 		return res.Result[0].ID, nil
 	default:
 		return "", errors.New("ambiguous zone name; an account ID might help")
@@ -206,44 +236,70 @@ func (api *API) makeRequestContextWithHeadersComplete(ctx context.Context, metho
 }
 
 func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) (*APIResponse, error) {
+	resp, err := api.doRequest(ctx, method, uri, params, authType, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	return &APIResponse{
+		Body:       respBody,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Headers:    resp.Header,
+	}, nil
+}
+
+// doRequest is the request pipeline shared by buffered and streamed requests.
+// It traces the request, adds the idempotency and conditional headers, retries
+// it according to the retry policy and converts unsuccessful responses into
+// errors. The body of the returned response is decompressed and left open;
+// the caller is responsible for closing it.
+func (api *API) doRequest(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) (*http.Response, error) {
+	if api.tracer == nil {
+		return api.doRequestWithRetries(ctx, method, uri, params, authType, headers)
+	}
+
+	ctx, span := api.startSpan(ctx, method, uri)
+	resp, err := api.doRequestWithRetries(ctx, method, uri, params, authType, headers)
+	endSpan(span, resp, err)
+
+	return resp, err
+}
+
+// doRequestWithRetries makes the HTTP request, retrying it according to the
+// retry policy.
+func (api *API) doRequestWithRetries(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) (*http.Response, error) {
 	var err error
 	var resp *http.Response
 	var respErr error
-	var respBody []byte
+
+	headers, err = api.idempotencyHeaders(ctx, method, uri, params, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := conditionalRequest(ctx, method)
+	if cond != nil {
+		headers = cond.headers(uri, headers)
+	}
 
 	for i := 0; i <= api.retryPolicy.MaxRetries; i++ {
 		var reqBody io.Reader
-		if params != nil {
-			if r, ok := params.(io.Reader); ok {
-				reqBody = r
-			} else if paramBytes, ok := params.([]byte); ok {
-				reqBody = bytes.NewReader(paramBytes)
-			} else {
-				var jsonBody []byte
-				jsonBody, err = json.Marshal(params)
-				if err != nil {
-					return nil, fmt.Errorf("error marshalling params to JSON: %w", err)
-				}
-				reqBody = bytes.NewReader(jsonBody)
-			}
+		reqBody, err = requestBody(params)
+		if err != nil {
+			return nil, err
 		}
 
 		if i > 0 {
-			// expect the backoff introduced here on errored requests to dominate the effect of rate limiting
-			// don't need a random component here as the rate limiter should do something similar
-			// nb time duration could truncate an arbitrary float. Since our inputs are all ints, we should be ok
-			sleepDuration := time.Duration(math.Pow(2, float64(i-1)) * float64(api.retryPolicy.MinRetryDelay))
-
-			if sleepDuration > api.retryPolicy.MaxRetryDelay {
-				sleepDuration = api.retryPolicy.MaxRetryDelay
-			}
-			// useful to do some simple logging here, maybe introduce levels later
-			api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), i, method, uri)
-
-			select {
-			case <-time.After(sleepDuration):
-			case <-ctx.Done():
-				return nil, fmt.Errorf("operation aborted during backoff: %w", ctx.Err())
+			err = api.backoff(ctx, i, method, uri)
+			if err != nil {
+				return nil, err
 			}
 		}
 
@@ -259,26 +315,23 @@ func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, m
 			return nil, respErr
 		}
 
-		// retry if the server is rate limiting us or if it failed
-		// assumes server operations are rolled back on failure
-		if respErr != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
-			if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-				respErr = errors.New("exceeded available rate limit retries")
+		// retry if the server is rate limiting us or if it failed in a way
+		// that is safe to retry for this request
+		if api.shouldRetry(method, headers, resp, respErr) {
+			if resp != nil {
+				resp.Body.Close()
+				if resp.StatusCode == http.StatusTooManyRequests {
+					respErr = errors.New("exceeded available rate limit retries")
+				}
 			}
 
 			if respErr == nil {
 				respErr = fmt.Errorf("received %s response (HTTP %d), please try again later", strings.ToLower(http.StatusText(resp.StatusCode)), resp.StatusCode)
 			}
 			continue
-		} else {
-			respBody, err = io.ReadAll(resp.Body)
-			defer resp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("could not read response body: %w", err)
-			}
-
-			break
 		}
+
+		break
 	}
 
 	// still had an error after all retries
@@ -286,68 +339,197 @@ func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, m
 		return nil, respErr
 	}
 
+	body, err := decompressResponseBody(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+	resp.Body = body
+
 	if resp.StatusCode >= http.StatusBadRequest {
-		if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
-			return nil, fmt.Errorf("%s", respBody)
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not read response body: %w", err)
 		}
+		return nil, errorFromResponse(resp, respBody)
+	}
 
-		if resp.StatusCode >= http.StatusInternalServerError {
-			return nil, &ServiceError{cloudflareError: &Error{
-				StatusCode: resp.StatusCode,
-				RayID:      resp.Header.Get("cf-ray"),
-				Errors: []ResponseInfo{{
-					Message: errInternalServiceError,
-				}},
-			}}
+	if cond != nil {
+		err = cond.response(uri, resp)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		errBody := &Response{}
-		err = json.Unmarshal(respBody, &errBody)
+	return resp, nil
+}
+
+// makeRequestStream makes a HTTP request and returns the response body
+// without reading it into memory when response streaming has been enabled
+// using `WithResponseStreaming`. Otherwise the body is buffered as usual and
+// wrapped in an io.ReadCloser. The caller is responsible for closing the
+// returned body.
+func (api *API) makeRequestStream(ctx context.Context, method, uri string, params interface{}) (io.ReadCloser, error) {
+	if !api.responseStreaming {
+		res, err := api.makeRequestContext(ctx, method, uri, params)
 		if err != nil {
-			return nil, fmt.Errorf(errUnmarshalErrorBody+": %w", err)
+			return nil, err
 		}
+		return io.NopCloser(bytes.NewReader(res)), nil
+	}
 
-		errCodes := make([]int, 0, len(errBody.Errors))
-		errMsgs := make([]string, 0, len(errBody.Errors))
-		for _, e := range errBody.Errors {
-			errCodes = append(errCodes, e.Code)
-			errMsgs = append(errMsgs, e.Message)
-		}
+	return api.openRequestStream(ctx, method, uri, params, nil)
+}
 
-		err := &Error{
-			StatusCode:    resp.StatusCode,
-			RayID:         resp.Header.Get("cf-ray"),
-			Errors:        errBody.Errors,
-			ErrorCodes:    errCodes,
-			ErrorMessages: errMsgs,
-			Messages:      errBody.Messages,
-		}
+// openRequestStream makes a HTTP request and returns the response body
+// without reading it into memory, regardless of `WithResponseStreaming`. The
+// caller is responsible for closing the returned body.
+func (api *API) openRequestStream(ctx context.Context, method, uri string, params interface{}, headers http.Header) (io.ReadCloser, error) {
+	resp, err := api.doRequest(ctx, method, uri, params, api.authType, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// requestBody converts the params of a request into the request body. Readers
+// and byte slices are sent as is, anything else is serialized to JSON.
+func requestBody(params interface{}) (io.Reader, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	if r, ok := params.(io.Reader); ok {
+		return r, nil
+	}
 
-		switch resp.StatusCode {
-		case http.StatusUnauthorized:
-			err.Type = ErrorTypeAuthorization
-			return nil, &AuthorizationError{cloudflareError: err}
-		case http.StatusForbidden:
-			err.Type = ErrorTypeAuthentication
-			return nil, &AuthenticationError{cloudflareError: err}
-		case http.StatusNotFound:
-			err.Type = ErrorTypeNotFound
-			return nil, &NotFoundError{cloudflareError: err}
-		case http.StatusTooManyRequests:
-			err.Type = ErrorTypeRateLimit
-			return nil, &RatelimitError{cloudflareError: err}
-		default:
-			err.Type = ErrorTypeRequest
-			return nil, &RequestError{cloudflareError: err}
+	if paramBytes, ok := params.([]byte); ok {
+		return bytes.NewReader(paramBytes), nil
+	}
+
+	jsonBody, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling params to JSON: %w", err)
+	}
+
+	return bytes.NewReader(jsonBody), nil
+}
+
+// decompressResponseBody returns the body of the response, transparently
+// decompressing it when it is gzip encoded and the transport has not already
+// done so (e.g. when the Accept-Encoding header was set explicitly).
+func decompressResponseBody(resp *http.Response) (io.ReadCloser, error) {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp.Body, nil
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return resp.Body, nil
 		}
+		return nil, err
 	}
 
-	return &APIResponse{
-		Body:       respBody,
-		StatusCode: resp.StatusCode,
-		Status:     resp.Status,
-		Headers:    resp.Header,
-	}, nil
+	return &gzipReadCloser{Reader: gz, body: resp.Body}, nil
+}
+
+// gzipReadCloser closes both the gzip reader and the underlying body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.Closer
+}
+
+func (g *gzipReadCloser) Close() error {
+	gzErr := g.Reader.Close()
+	if err := g.body.Close(); err != nil {
+		return err
+	}
+	return gzErr
+}
+
+// backoff sleeps before the given retry attempt, doubling the delay for each
+// attempt within the bounds of the retry policy.
+func (api *API) backoff(ctx context.Context, attempt int, method, uri string) error {
+	// expect the backoff introduced here on errored requests to dominate the effect of rate limiting
+	// don't need a random component here as the rate limiter should do something similar
+	// nb time duration could truncate an arbitrary float. Since our inputs are all ints, we should be ok
+	sleepDuration := time.Duration(math.Pow(2, float64(attempt-1)) * float64(api.retryPolicy.MinRetryDelay))
+
+	if sleepDuration > api.retryPolicy.MaxRetryDelay {
+		sleepDuration = api.retryPolicy.MaxRetryDelay
+	}
+	// useful to do some simple logging here, maybe introduce levels later
+	api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), attempt, method, uri)
+
+	select {
+	case <-time.After(sleepDuration):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("operation aborted during backoff: %w", ctx.Err())
+	}
+}
+
+// errorFromResponse converts an unsuccessful API response into the matching
+// error type.
+func errorFromResponse(resp *http.Response, respBody []byte) error {
+	if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
+		return fmt.Errorf("%s", respBody)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &ServiceError{cloudflareError: &Error{
+			StatusCode: resp.StatusCode,
+			RayID:      resp.Header.Get("cf-ray"),
+			Errors: []ResponseInfo{{
+				Message: errInternalServiceError,
+			}},
+		}}
+	}
+
+	errBody := &Response{}
+	if strings.HasSuffix(resp.Request.URL.Path, "/analytics_engine/sql") && !json.Valid(respBody) {
+		// The Analytics Engine SQL API returns errors as plain text.
+		errBody.Errors = []ResponseInfo{{Message: strings.TrimSpace(string(respBody))}}
+	} else if err := json.Unmarshal(respBody, &errBody); err != nil {
+		return fmt.Errorf(errUnmarshalErrorBody+": %w", err)
+	}
+
+	errCodes := make([]int, 0, len(errBody.Errors))
+	errMsgs := make([]string, 0, len(errBody.Errors))
+	for _, e := range errBody.Errors {
+		errCodes = append(errCodes, e.Code)
+		errMsgs = append(errMsgs, e.Message)
+	}
+
+	err := &Error{
+		StatusCode:    resp.StatusCode,
+		RayID:         resp.Header.Get("cf-ray"),
+		Errors:        errBody.Errors,
+		ErrorCodes:    errCodes,
+		ErrorMessages: errMsgs,
+		Messages:      errBody.Messages,
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err.Type = ErrorTypeAuthorization
+		return &AuthorizationError{cloudflareError: err}
+	case http.StatusForbidden:
+		err.Type = ErrorTypeAuthentication
+		return &AuthenticationError{cloudflareError: err}
+	case http.StatusNotFound:
+		err.Type = ErrorTypeNotFound
+		return &NotFoundError{cloudflareError: err}
+	case http.StatusTooManyRequests:
+		err.Type = ErrorTypeRateLimit
+		return &RatelimitError{cloudflareError: err}
+	default:
+		err.Type = ErrorTypeRequest
+		return &RequestError{cloudflareError: err}
+	}
 }
 
 // request makes a HTTP request to the given API endpoint, returning the raw
@@ -390,17 +572,18 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 		}
 
 		// Strip out any sensitive information from the request payload.
-		sensitiveKeys := []string{api.APIKey, api.APIEmail, api.APIToken, api.APIUserServiceKey}
-		for _, key := range sensitiveKeys {
-			if key != "" {
-				valueRegex := regexp.MustCompile(fmt.Sprintf("(?m)%s", key))
-				dump = valueRegex.ReplaceAll(dump, []byte("[redacted]"))
-			}
-		}
-		log.Printf("\n%s", string(dump))
+		log.Printf("\n%s", api.redactDump(req.URL.Path, dump))
 	}
 
-	resp, err := api.httpClient.Do(req)
+	start := time.Now()
+	resp, err := api.send(req)
+	if api.logRequests {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		api.logger.Printf("%s %s status=%d duration=%s auth=%s", method, redactURL(req.URL), status, time.Since(start), redactAuthHeaders(req.Header))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
@@ -410,7 +593,7 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 		if err != nil {
 			return resp, err
 		}
-		log.Printf("\n%s", string(dump))
+		log.Printf("\n%s", api.redactDump(req.URL.Path, dump))
 	}
 
 	return resp, nil
@@ -484,12 +667,96 @@ type PaginationOptions struct {
 	PerPage int `json:"per_page,omitempty" url:"per_page,omitempty"`
 }
 
+// CursorPaginationOptions can be passed to a list request for endpoints
+// paginated with opaque cursors rather than page numbers. The cursor of the
+// next page is returned by ResultInfo.NextCursor.
+type CursorPaginationOptions struct {
+	Cursor  string `json:"cursor,omitempty" url:"cursor,omitempty"`
+	PerPage int    `json:"per_page,omitempty" url:"per_page,omitempty"`
+}
+
 // RetryPolicy specifies number of retries and min/max retry delays
 // This config is used when the client exponentially backs off after errored requests.
+//
+// Rate limited (HTTP 429) responses are always retried. Other failures are
+// only retried when both the status code (or a connection error) and the
+// request method are retryable, or the request carries an idempotency key.
 type RetryPolicy struct {
 	MaxRetries    int
 	MinRetryDelay time.Duration
 	MaxRetryDelay time.Duration
+
+	// RetryableStatusCodes are the response status codes that are retried.
+	// Defaults to 502, 503 and 504 when empty.
+	RetryableStatusCodes []int
+
+	// RetryableMethods are the HTTP methods that are retried. Defaults to
+	// the idempotent methods GET, HEAD, OPTIONS, PUT and DELETE when empty.
+	RetryableMethods []string
+}
+
+var (
+	defaultRetryableStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	defaultRetryableMethods     = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+)
+
+// shouldRetry reports whether the client should retry a request that
+// resulted in resp or err. Retries are left to the Doer when one is
+// configured so that requests aren't retried twice.
+func (api *API) shouldRetry(method string, headers http.Header, resp *http.Response, err error) bool {
+	if api.doer != nil {
+		return false
+	}
+
+	if headers.Get(IdempotencyKeyHeader) == "" {
+		headers = api.headers
+	}
+
+	return api.retryPolicy.shouldRetry(method, headers, resp, err)
+}
+
+// shouldRetry reports whether a request that resulted in resp or err should
+// be retried.
+func (p RetryPolicy) shouldRetry(method string, headers http.Header, resp *http.Response, err error) bool {
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if err == nil {
+		codes := p.RetryableStatusCodes
+		if len(codes) == 0 {
+			codes = defaultRetryableStatusCodes
+		}
+
+		retryableStatus := false
+		for _, code := range codes {
+			if resp.StatusCode == code {
+				retryableStatus = true
+				break
+			}
+		}
+
+		if !retryableStatus {
+			return false
+		}
+	}
+
+	if headers.Get(IdempotencyKeyHeader) != "" {
+		return true
+	}
+
+	methods := p.RetryableMethods
+	if len(methods) == 0 {
+		methods = defaultRetryableMethods
+	}
+
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Logger defines the interface this library needs to use logging
@@ -1,10 +1,12 @@
 package cloudflare
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -122,7 +124,8 @@ func TestClient_Headers(t *testing.T) {
 }
 
 func TestClient_RetryCanSucceedAfterErrors(t *testing.T) {
-	setup(UsingRetryPolicy(2, 0, 1))
+	// POST requests are only retried when they carry an idempotency key.
+	setup(UsingRetryPolicy(2, 0, 1), WithAutoIdempotency())
 	defer teardown()
 
 	requestsReceived := 0
@@ -137,7 +140,7 @@ func TestClient_RetryCanSucceedAfterErrors(t *testing.T) {
 		// we are doing three *retries*
 		if requestsReceived == 0 {
 			// return error causing client to retry
-			w.WriteHeader(500)
+			w.WriteHeader(502)
 			fmt.Fprint(w, `{
 				"success": false,
 				"errors": [ "server created some error"],
@@ -547,3 +550,217 @@ func TestCheckResultInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_DecompressesGzipResponses(t *testing.T) {
+	headers := make(http.Header)
+	headers.Set("Accept-Encoding", "gzip")
+	setup(Headers(headers))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		fmt.Fprint(gz, "www.example.com. 1 IN A 192.0.2.1")
+		gz.Close()
+	})
+
+	export, err := client.ZoneExport(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "www.example.com. 1 IN A 192.0.2.1", export)
+	}
+}
+
+func TestClient_RequestTimeoutAndTransport(t *testing.T) {
+	transport := &http.Transport{MaxIdleConnsPerHost: 64}
+
+	api, err := New("deadbeef", "cloudflare@example.org", WithRequestTimeout(5*time.Second), WithTransport(transport))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 5*time.Second, api.httpClient.Timeout)
+		assert.Same(t, transport, api.httpClient.Transport)
+		assert.NotSame(t, http.DefaultClient, api.httpClient)
+	}
+
+	api, err = New("deadbeef", "cloudflare@example.org")
+	if assert.NoError(t, err) {
+		assert.Same(t, http.DefaultClient, api.httpClient)
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", WithRequestTimeout(-time.Second))
+	assert.Error(t, err)
+}
+
+func TestClient_RequestTimeoutIsEnforced(t *testing.T) {
+	setup(WithRequestTimeout(50 * time.Millisecond))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	})
+
+	_, err := client.ZoneExport(context.Background(), testZoneID)
+	assert.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestClient_HTTPClientTakesPrecedence(t *testing.T) {
+	var called bool
+	httpClient := &http.Client{
+		Transport: RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			called = true
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(http.StatusOK)
+			return rec.Result(), nil
+		}),
+	}
+
+	// WithHTTPClient wins regardless of the order options are given in.
+	for _, opts := range [][]Option{
+		{WithHTTPClient(httpClient), WithRequestTimeout(time.Nanosecond), WithTransport(&http.Transport{})},
+		{WithRequestTimeout(time.Nanosecond), WithTransport(&http.Transport{}), WithHTTPClient(httpClient)},
+	} {
+		called = false
+		cfClient, err := New("deadbeef", "cloudflare@example.org", append([]Option{UsingRetryPolicy(0, 0, 0)}, opts...)...)
+		if assert.NoError(t, err) {
+			_, err = cfClient.ZoneExport(context.Background(), testZoneID)
+			assert.NoError(t, err)
+			assert.True(t, called)
+		}
+	}
+}
+
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(r *http.Request) (*http.Response, error) { return f(r) }
+
+// testServerDoer sends requests for the given hosts, which aren't part of the
+// API, to the test server.
+func testServerDoer(hosts ...string) Doer {
+	return doerFunc(func(r *http.Request) (*http.Response, error) {
+		for _, host := range hosts {
+			if r.URL.Host == host {
+				u, _ := url.Parse(server.URL)
+				r = r.Clone(r.Context())
+				r.URL.Scheme, r.URL.Host = u.Scheme, u.Host
+				break
+			}
+		}
+		return http.DefaultClient.Do(r)
+	})
+}
+
+func TestClient_WithDoerDisablesRetries(t *testing.T) {
+	calls := 0
+	doer := doerFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		rec := httptest.NewRecorder()
+		rec.Header().Set("content-type", "application/json")
+		rec.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(rec, `{"success": false, "errors": [{"code": 10000, "message": "service unavailable"}], "messages": [], "result": null}`)
+		return rec.Result(), nil
+	})
+
+	cfClient, err := New("deadbeef", "cloudflare@example.org", WithDoer(doer), UsingRetryPolicy(3, 0, 0))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = cfClient.ZoneExport(context.Background(), testZoneID)
+	assert.Equal(t, 1, calls, "the client must not retry requests made by a Doer")
+
+	var serviceErr *ServiceError
+	assert.ErrorAs(t, err, &serviceErr)
+
+	_, err = New("deadbeef", "cloudflare@example.org", WithDoer(nil))
+	assert.Error(t, err)
+}
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestClient_WithLogger(t *testing.T) {
+	logger := &recordingLogger{}
+	setup(WithLogger(logger))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := client.makeRequestContext(context.Background(), http.MethodGet, "/zones/"+testZoneID+"/dns_records/export?api_token=s3cr3t&page=1", nil)
+	assert.NoError(t, err)
+
+	if assert.Len(t, logger.lines, 1) {
+		line := logger.lines[0]
+		assert.Contains(t, line, "GET "+server.URL+"/zones/"+testZoneID+"/dns_records/export?api_token=%5Bredacted%5D&page=1")
+		assert.Contains(t, line, "status=200")
+		assert.Contains(t, line, "duration=")
+		assert.Contains(t, line, "auth=X-Auth-Email: [redacted], X-Auth-Key: [redacted]")
+		assert.NotContains(t, line, "s3cr3t")
+		assert.NotContains(t, line, "deadbeef")
+	}
+}
+
+func TestClient_WithNilLogger(t *testing.T) {
+	_, err := New("deadbeef", "cloudflare@example.org", WithLogger(nil))
+	assert.ErrorContains(t, err, "logger cannot be nil")
+}
+
+func TestClient_RedactDump(t *testing.T) {
+	api, _ := NewWithAPIToken("my-token")
+
+	dump := api.redactDump("/accounts/foo/access/service_tokens", []byte("POST /accounts/foo/access/service_tokens HTTP/1.1\r\nAuthorization: Bearer my-token\r\n\r\n"+`{"name":"ci","client_secret": "abc\"def","password":"hunter2"}`))
+	assert.NotContains(t, string(dump), "my-token")
+	assert.NotContains(t, string(dump), "abc")
+	assert.NotContains(t, string(dump), "hunter2")
+	assert.Contains(t, string(dump), `"name":"ci"`)
+
+	dump = api.redactDump("/accounts/foo/storage/kv/namespaces/bar/values/key", []byte("PUT /accounts/foo/storage/kv/namespaces/bar/values/key HTTP/1.1\r\nContent-Type: text/plain\r\n\r\nsuper secret value"))
+	assert.NotContains(t, string(dump), "super secret value")
+	assert.Contains(t, string(dump), "Content-Type: text/plain")
+}
+
+func TestClient_RetryClassification(t *testing.T) {
+	tests := map[string]struct {
+		opts     []Option
+		method   string
+		status   int
+		attempts int
+	}{
+		"GET is retried on 503":                {method: http.MethodGet, status: http.StatusServiceUnavailable, attempts: 3},
+		"DELETE is retried on 504":             {method: http.MethodDelete, status: http.StatusGatewayTimeout, attempts: 3},
+		"GET is not retried on 500":            {method: http.MethodGet, status: http.StatusInternalServerError, attempts: 1},
+		"POST is not retried on 503":           {method: http.MethodPost, status: http.StatusServiceUnavailable, attempts: 1},
+		"POST is retried on 429":               {method: http.MethodPost, status: http.StatusTooManyRequests, attempts: 3},
+		"POST is retried with idempotency":     {opts: []Option{WithAutoIdempotency()}, method: http.MethodPost, status: http.StatusServiceUnavailable, attempts: 3},
+		"custom status codes and methods":      {opts: []Option{WithRetryPolicy(RetryPolicy{MaxRetries: 2, RetryableStatusCodes: []int{500}, RetryableMethods: []string{http.MethodPost}})}, method: http.MethodPost, status: http.StatusInternalServerError, attempts: 3},
+		"custom methods exclude the defaults":  {opts: []Option{WithRetryPolicy(RetryPolicy{MaxRetries: 2, RetryableMethods: []string{http.MethodPost}})}, method: http.MethodGet, status: http.StatusServiceUnavailable, attempts: 1},
+		"custom status codes exclude defaults": {opts: []Option{WithRetryPolicy(RetryPolicy{MaxRetries: 2, RetryableStatusCodes: []int{500}})}, method: http.MethodGet, status: http.StatusBadGateway, attempts: 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			setup(append([]Option{UsingRetryPolicy(2, 0, 0)}, tc.opts...)...)
+			defer teardown()
+
+			attempts := 0
+			mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.Header().Set("content-type", "application/json")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, `{"success": false, "errors": [{"code": 1000, "message": "error"}], "messages": [], "result": null}`)
+			})
+
+			_, err := client.makeRequestContext(context.Background(), tc.method, "/zones/"+testZoneID+"/dns_records", nil)
+			assert.Error(t, err)
+			assert.Equal(t, tc.attempts, attempts)
+		})
+	}
+}
@@ -61,12 +61,12 @@ func zoneCheck(c *cli.Context) error {
 		return err
 	}
 
-	res, err := api.ZoneActivationCheck(context.Background(), zoneID)
+	res, err := api.ZoneActivationCheck(context.Background(), cloudflare.ZoneIdentifier(zoneID))
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
-	fmt.Printf("%s\n", res.Messages[0].Message)
+	fmt.Printf("Activation check initiated for zone %s\n", res.ID)
 
 	return nil
 }
@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	// conditionalRequestMaxResponses limits the number of responses cached by
+	// a ConditionalRequest. The oldest response is evicted first.
+	conditionalRequestMaxResponses = 64
+
+	// conditionalRequestMaxResponseSize is the size of the largest response
+	// body that is cached. Larger responses are always downloaded again.
+	conditionalRequestMaxResponseSize = 1 << 20
+)
+
+// ErrNotModified is returned by reads made with a context from
+// WithConditionalRequest when the API responds with 304 Not Modified to
+// validators set with SetValidators and the response isn't cached. The value
+// the caller read before is still current.
+var ErrNotModified = errors.New("resource not modified")
+
+// ConditionalRequest makes GET requests conditional so that resources are only
+// downloaded again when they have changed. Reads made with a context from
+// WithConditionalRequest send the ETag and Last-Modified validators of the
+// previous response for the same URI, and when the API responds with 304 Not
+// Modified the previous response is used instead, so methods that make several
+// reads, such as auto-paginating lists or read-modify-write helpers, work as
+// usual. Use Modified to find out whether any of the reads returned changed
+// data.
+//
+// Callers that keep the value and validators of a read themselves, for example
+// across restarts, can set them with SetValidators instead. When the resource
+// hasn't changed the read then returns ErrNotModified.
+//
+// A limited number of small responses is kept in memory.
+type ConditionalRequest struct {
+	mu        sync.Mutex
+	responses map[string]*conditionalResponse
+	// order holds the cached URIs from oldest to newest.
+	order []string
+
+	etag         string
+	lastModified string
+	// pending is set when the validators were set by the caller and haven't
+	// been sent yet.
+	pending  bool
+	modified bool
+}
+
+// conditionalResponse is a cached response and its validators.
+type conditionalResponse struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+type conditionalRequestContextKey struct{}
+
+// WithConditionalRequest returns a context that makes GET requests made with
+// it conditional on the responses cached in cond.
+func WithConditionalRequest(ctx context.Context, cond *ConditionalRequest) context.Context {
+	return context.WithValue(ctx, conditionalRequestContextKey{}, cond)
+}
+
+// SetValidators sets the ETag and Last-Modified values sent as If-None-Match
+// and If-Modified-Since with the next read, for a resource that was read
+// before without the ConditionalRequest. Either value may be empty. As only
+// the next read is made conditional, they should be used with methods that
+// make a single request.
+func (cond *ConditionalRequest) SetValidators(etag, lastModified string) {
+	cond.mu.Lock()
+	defer cond.mu.Unlock()
+
+	cond.etag = etag
+	cond.lastModified = lastModified
+	cond.pending = true
+}
+
+// ETag returns the ETag of the most recent response, or the value set with
+// SetValidators if no read has been made since.
+func (cond *ConditionalRequest) ETag() string {
+	cond.mu.Lock()
+	defer cond.mu.Unlock()
+
+	return cond.etag
+}
+
+// LastModified returns the Last-Modified value of the most recent response, or
+// the value set with SetValidators if no read has been made since.
+func (cond *ConditionalRequest) LastModified() string {
+	cond.mu.Lock()
+	defer cond.mu.Unlock()
+
+	return cond.lastModified
+}
+
+// Modified reports whether a read made with the ConditionalRequest returned a
+// new or changed resource since the previous call to Modified, for example to
+// skip reconciling a resource that hasn't changed since it was last polled.
+//
+// Methods return the cached data for unchanged resources rather than an error
+// because most of them discard their result when an error is returned.
+func (cond *ConditionalRequest) Modified() bool {
+	cond.mu.Lock()
+	defer cond.mu.Unlock()
+
+	modified := cond.modified
+	cond.modified = false
+
+	return modified
+}
+
+// conditionalRequest returns the ConditionalRequest attached to the context
+// for requests that can be made conditional.
+func conditionalRequest(ctx context.Context, method string) *ConditionalRequest {
+	if method != http.MethodGet {
+		return nil
+	}
+
+	cond, _ := ctx.Value(conditionalRequestContextKey{}).(*ConditionalRequest)
+	return cond
+}
+
+// headers returns the headers for a request to uri with the validators of the
+// cached response, or the ones set with SetValidators, added.
+func (cond *ConditionalRequest) headers(uri string, headers http.Header) http.Header {
+	cond.mu.Lock()
+	etag, lastModified := "", ""
+	if cached := cond.responses[uri]; cached != nil {
+		etag, lastModified = cached.etag, cached.lastModified
+	} else if cond.pending {
+		etag, lastModified = cond.etag, cond.lastModified
+	}
+	cond.pending = false
+	cond.mu.Unlock()
+
+	if etag == "" && lastModified == "" {
+		return headers
+	}
+
+	h := make(http.Header)
+	copyHeader(h, headers)
+	if etag != "" {
+		h.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		h.Set("If-Modified-Since", lastModified)
+	}
+
+	return h
+}
+
+// response replaces a 304 Not Modified response with the cached response for
+// uri, and caches other successful responses that have validators. The body of
+// cached responses is read into memory. ErrNotModified is returned for a 304
+// response that isn't cached.
+func (cond *ConditionalRequest) response(uri string, resp *http.Response) error {
+	cond.mu.Lock()
+	defer cond.mu.Unlock()
+
+	cached := cond.responses[uri]
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		if cached == nil {
+			return ErrNotModified
+		}
+
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = cached.header.Clone()
+		resp.Body = io.NopCloser(bytes.NewReader(cached.body))
+		cond.etag, cond.lastModified = cached.etag, cached.lastModified
+		return nil
+	}
+
+	cond.modified = true
+	cond.etag, cond.lastModified = resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	cond.evict(uri)
+
+	if cond.etag == "" && cond.lastModified == "" {
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, conditionalRequestMaxResponseSize+1))
+	if err != nil {
+		resp.Body.Close()
+		return fmt.Errorf("could not read response body: %w", err)
+	}
+	if len(body) > conditionalRequestMaxResponseSize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if cond.responses == nil {
+		cond.responses = make(map[string]*conditionalResponse)
+	}
+	if len(cond.order) >= conditionalRequestMaxResponses {
+		cond.evict(cond.order[0])
+	}
+	cond.responses[uri] = &conditionalResponse{
+		etag:         cond.etag,
+		lastModified: cond.lastModified,
+		header:       resp.Header.Clone(),
+		body:         body,
+	}
+	cond.order = append(cond.order, uri)
+
+	return nil
+}
+
+// evict removes the cached response for uri.
+func (cond *ConditionalRequest) evict(uri string) {
+	if _, ok := cond.responses[uri]; !ok {
+		return
+	}
+
+	delete(cond.responses, uri)
+	for i, u := range cond.order {
+		if u == uri {
+			cond.order = append(cond.order[:i], cond.order[i+1:]...)
+			break
+		}
+	}
+}
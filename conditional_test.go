@@ -0,0 +1,210 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithConditionalRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const etag = `W/"5f1b6e2a"`
+	const lastModified = "Wed, 02 Oct 2024 08:00:00 GMT"
+
+	var requests []http.Header
+	mux.HandleFunc("/zones/foo/settings/http3", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header)
+		if r.Method == http.MethodGet && r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `{"result": {"id": "http3", "value": "on", "editable": true}}`)
+	})
+
+	var cond ConditionalRequest
+	ctx := WithConditionalRequest(context.Background(), &cond)
+	rc := ZoneIdentifier("foo")
+
+	on, err := client.GetHTTP3(ctx, rc)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+	assert.True(t, cond.Modified())
+	assert.Equal(t, etag, cond.ETag())
+	assert.Equal(t, lastModified, cond.LastModified())
+
+	// The cached value is returned for an unchanged resource.
+	on, err = client.GetHTTP3(ctx, rc)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+	assert.False(t, cond.Modified())
+
+	// Writes are never made conditional.
+	_, err = client.SetHTTP3(ctx, rc, true)
+	assert.NoError(t, err)
+
+	if assert.Len(t, requests, 3) {
+		assert.Empty(t, requests[0].Get("If-None-Match"))
+		assert.Equal(t, etag, requests[1].Get("If-None-Match"))
+		assert.Equal(t, lastModified, requests[1].Get("If-Modified-Since"))
+		assert.Empty(t, requests[2].Get("If-None-Match"))
+	}
+
+	// Reads without the context are unaffected.
+	on, err = client.GetHTTP3(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestWithConditionalRequest_Pagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pageETags := map[string]string{"1": `"page-1-v1"`, "2": `"page-2-v1"`}
+	var sent []string
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		sent = append(sent, page+" "+r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == pageETags[page] {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", pageETags[page])
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "record-%s", "type": "A", "name": "www.example.com", "content": "192.0.2.%s"}],
+			"result_info": {"page": %s, "per_page": 100, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page, page, page)
+	})
+
+	var cond ConditionalRequest
+	ctx := WithConditionalRequest(context.Background(), &cond)
+
+	records, _, err := client.ListDNSRecords(ctx, ZoneIdentifier(testZoneID), ListDNSRecordsParams{})
+	if assert.NoError(t, err) {
+		assert.Len(t, records, 2)
+	}
+	assert.True(t, cond.Modified())
+
+	records, _, err = client.ListDNSRecords(ctx, ZoneIdentifier(testZoneID), ListDNSRecordsParams{})
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, "record-1", records[0].ID)
+		assert.Equal(t, "record-2", records[1].ID)
+	}
+	assert.False(t, cond.Modified())
+
+	pageETags["2"] = `"page-2-v2"`
+	_, _, err = client.ListDNSRecords(ctx, ZoneIdentifier(testZoneID), ListDNSRecordsParams{})
+	assert.NoError(t, err)
+	assert.True(t, cond.Modified())
+
+	// Each page is sent the validators of its own previous response.
+	assert.Equal(t, []string{
+		"1 ", "2 ",
+		`1 "page-1-v1"`, `2 "page-2-v1"`,
+		`1 "page-1-v1"`, `2 "page-2-v1"`,
+	}, sent)
+}
+
+func TestWithConditionalRequest_SetValidators(t *testing.T) {
+	setup()
+	defer teardown()
+
+	currentETag := `"v1"`
+	mux.HandleFunc("/zones/foo/settings/http3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == currentETag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", currentETag)
+		fmt.Fprint(w, `{"result": {"id": "http3", "value": "off", "editable": true}}`)
+	})
+
+	var cond ConditionalRequest
+	cond.SetValidators(`"v1"`, "")
+	ctx := WithConditionalRequest(context.Background(), &cond)
+
+	// The value read before is still current.
+	_, err := client.GetHTTP3(ctx, ZoneIdentifier("foo"))
+	assert.ErrorIs(t, err, ErrNotModified)
+	assert.False(t, cond.Modified())
+	assert.Equal(t, `"v1"`, cond.ETag())
+
+	currentETag = `"v2"`
+	cond.SetValidators(`"v1"`, "")
+	on, err := client.GetHTTP3(ctx, ZoneIdentifier("foo"))
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+	assert.True(t, cond.Modified())
+	assert.Equal(t, `"v2"`, cond.ETag())
+}
+
+func TestWithConditionalRequest_NotModifiedWithoutCachedResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/http3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	var cond ConditionalRequest
+	_, err := client.GetHTTP3(WithConditionalRequest(context.Background(), &cond), ZoneIdentifier("foo"))
+	assert.ErrorIs(t, err, ErrNotModified)
+	assert.False(t, cond.Modified())
+}
+
+func TestConditionalRequest_CacheLimits(t *testing.T) {
+	response := func(etag string, body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": []string{etag}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	var cond ConditionalRequest
+	for i := 0; i <= conditionalRequestMaxResponses; i++ {
+		uri := "/zones/" + strconv.Itoa(i)
+		assert.NoError(t, cond.response(uri, response(`"`+strconv.Itoa(i)+`"`, "{}")))
+	}
+
+	// The oldest response is evicted.
+	assert.Len(t, cond.responses, conditionalRequestMaxResponses)
+	assert.Len(t, cond.order, conditionalRequestMaxResponses)
+	assert.NotContains(t, cond.responses, "/zones/0")
+	assert.Empty(t, cond.headers("/zones/0", http.Header{}).Get("If-None-Match"))
+	assert.Equal(t, `"1"`, cond.headers("/zones/1", http.Header{}).Get("If-None-Match"))
+
+	// Large responses are passed through without being cached.
+	large := strings.Repeat("a", conditionalRequestMaxResponseSize+10)
+	resp := response(`"large"`, large)
+	if assert.NoError(t, cond.response("/zones/large", resp)) {
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Equal([]byte(large), body))
+	}
+	assert.NotContains(t, cond.responses, "/zones/large")
+	assert.Equal(t, `"large"`, cond.ETag())
+}
@@ -28,6 +28,14 @@ const (
 	BLOCKED CustomHostnameStatus = "blocked"
 )
 
+// ErrInvalidCustomHostnameMinTLSVersion is returned when the SSL settings of a
+// custom hostname contain a minimum TLS version that isn't supported.
+var ErrInvalidCustomHostnameMinTLSVersion = errors.New("invalid custom hostname minimum TLS version, must be one of 1.0, 1.1, 1.2 or 1.3")
+
+// customHostnameMinTLSVersions contains the allowed values for
+// `CustomHostnameSSLSettings.MinTLSVersion`.
+var customHostnameMinTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
 // CustomHostnameSSLSettings represents the SSL settings for a custom hostname.
 type CustomHostnameSSLSettings struct {
 	HTTP2         string   `json:"http2,omitempty"`
@@ -50,6 +58,22 @@ type SSLValidationError struct {
 	Message string `json:"message,omitempty"`
 }
 
+// validateCustomHostnameSSL ensures the per-hostname TLS settings only contain
+// values the API will accept.
+func validateCustomHostnameSSL(ssl *CustomHostnameSSL) error {
+	if ssl == nil || ssl.Settings.MinTLSVersion == "" {
+		return nil
+	}
+
+	for _, v := range customHostnameMinTLSVersions {
+		if ssl.Settings.MinTLSVersion == v {
+			return nil
+		}
+	}
+
+	return ErrInvalidCustomHostnameMinTLSVersion
+}
+
 // CustomHostnameSSLCertificates represent certificate properties like issuer, expires date and etc.
 type CustomHostnameSSLCertificates struct {
 	Issuer            string     `json:"issuer"`
@@ -138,6 +162,10 @@ type CustomHostnameFallbackOriginResponse struct {
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-update-custom-hostname-configuration
 func (api *API) UpdateCustomHostnameSSL(ctx context.Context, zoneID string, customHostnameID string, ssl *CustomHostnameSSL) (*CustomHostnameResponse, error) {
+	if err := validateCustomHostnameSSL(ssl); err != nil {
+		return nil, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/custom_hostnames/%s", zoneID, customHostnameID)
 	ch := CustomHostname{
 		SSL: ssl,
@@ -160,6 +188,10 @@ func (api *API) UpdateCustomHostnameSSL(ctx context.Context, zoneID string, cust
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-update-custom-hostname-configuration
 func (api *API) UpdateCustomHostname(ctx context.Context, zoneID string, customHostnameID string, ch CustomHostname) (*CustomHostnameResponse, error) {
+	if err := validateCustomHostnameSSL(ch.SSL); err != nil {
+		return nil, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/custom_hostnames/%s", zoneID, customHostnameID)
 	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, ch)
 	if err != nil {
@@ -198,6 +230,10 @@ func (api *API) DeleteCustomHostname(ctx context.Context, zoneID string, customH
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-create-custom-hostname
 func (api *API) CreateCustomHostname(ctx context.Context, zoneID string, ch CustomHostname) (*CustomHostnameResponse, error) {
+	if err := validateCustomHostnameSSL(ch.SSL); err != nil {
+		return nil, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/custom_hostnames", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, ch)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -698,6 +699,64 @@ func TestCustomHostname_UpdateCustomHostnameSSL(t *testing.T) {
 	}
 }
 
+func TestCustomHostname_UpdateCustomHostnameSSL_TLSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		var ch CustomHostname
+		err := json.NewDecoder(r.Body).Decode(&ch)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2", ch.SSL.Settings.MinTLSVersion)
+		assert.Equal(t, []string{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"}, ch.SSL.Settings.Ciphers)
+		assert.Equal(t, "on", ch.SSL.Settings.HTTP2)
+		assert.Equal(t, "on", ch.SSL.Settings.TLS13)
+		assert.Equal(t, "on", ch.SSL.Settings.EarlyHints)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `
+{
+	"success": true,
+	"errors": [],
+	"messages": [],
+	"result": {
+		"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+		"hostname": "app.example.com",
+		"ssl": {
+			"settings": {
+				"http2": "on",
+				"tls_1_3": "on",
+				"min_tls_version": "1.2",
+				"ciphers": ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"],
+				"early_hints": "on"
+			}
+		}
+	}
+}`)
+	})
+
+	settings := CustomHostnameSSLSettings{
+		HTTP2:         "on",
+		TLS13:         "on",
+		MinTLSVersion: "1.2",
+		Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"},
+		EarlyHints:    "on",
+	}
+
+	response, err := client.UpdateCustomHostnameSSL(context.Background(), "foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", &CustomHostnameSSL{Settings: settings})
+	if assert.NoError(t, err) {
+		assert.Equal(t, settings, response.Result.SSL.Settings)
+	}
+
+	_, err = client.UpdateCustomHostnameSSL(context.Background(), "foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", &CustomHostnameSSL{Settings: CustomHostnameSSLSettings{MinTLSVersion: "1.4"}})
+	assert.ErrorIs(t, err, ErrInvalidCustomHostnameMinTLSVersion)
+
+	_, err = client.CreateCustomHostname(context.Background(), "foo", CustomHostname{Hostname: "app.example.com", SSL: &CustomHostnameSSL{Settings: CustomHostnameSSLSettings{MinTLSVersion: "TLSv1.2"}}})
+	assert.ErrorIs(t, err, ErrInvalidCustomHostnameMinTLSVersion)
+}
+
 func TestCustomHostname_UpdateCustomHostname(t *testing.T) {
 	setup()
 	defer teardown()
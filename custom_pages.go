@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,34 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var (
+	ErrInvalidCustomPageState = errors.New("custom page state must be default or customized")
+	ErrMissingCustomPageURL   = errors.New("customized custom pages require a URL")
+)
+
+// Identifiers of the custom pages that can be replaced.
+const (
+	CustomPageBasicChallenge   = "basic_challenge"
+	CustomPageWAFChallenge     = "waf_challenge"
+	CustomPageWAFBlock         = "waf_block"
+	CustomPageRateLimitBlock   = "ratelimit_block"
+	CustomPageCountryChallenge = "country_challenge"
+	CustomPageIPBlock          = "ip_block"
+	CustomPageUnderAttack      = "under_attack"
+	CustomPage500Errors        = "500_errors"
+	CustomPage1000Errors       = "1000_errors"
+	CustomPageAlwaysOnline     = "always_online"
+	CustomPageManagedChallenge = "managed_challenge"
+)
+
+// States of a custom page.
+const (
+	// CustomPageStateDefault serves Cloudflare's default page.
+	CustomPageStateDefault = "default"
+	// CustomPageStateCustomized serves the page fetched from the URL.
+	CustomPageStateCustomized = "customized"
+)
+
 // CustomPage represents a custom page configuration.
 type CustomPage struct {
 	CreatedOn      time.Time   `json:"created_on"`
@@ -143,6 +172,16 @@ func (api *API) UpdateCustomPage(ctx context.Context, options *CustomPageOptions
 		pageType, identifier string
 	)
 
+	switch pageParameters.State {
+	case CustomPageStateDefault:
+	case CustomPageStateCustomized:
+		if url, ok := pageParameters.URL.(string); pageParameters.URL == nil || (ok && url == "") {
+			return CustomPage{}, ErrMissingCustomPageURL
+		}
+	default:
+		return CustomPage{}, ErrInvalidCustomPageState
+	}
+
 	if options.AccountID == "" && options.ZoneID == "" {
 		return CustomPage{}, ErrAccountIDOrZoneIDAreRequired
 	}
@@ -349,3 +349,19 @@ func TestUpdateCustomPagesToDefault(t *testing.T) {
 		assert.Equal(t, defaultCustomPage, actual)
 	}
 }
+
+func TestUpdateCustomPageValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	options := &CustomPageOptions{ZoneID: testZoneID}
+
+	_, err := client.UpdateCustomPage(context.Background(), options, CustomPageWAFBlock, CustomPageParameters{State: "branded"})
+	assert.ErrorIs(t, err, ErrInvalidCustomPageState)
+
+	_, err = client.UpdateCustomPage(context.Background(), options, CustomPage500Errors, CustomPageParameters{State: CustomPageStateCustomized})
+	assert.ErrorIs(t, err, ErrMissingCustomPageURL)
+
+	_, err = client.UpdateCustomPage(context.Background(), options, CustomPage500Errors, CustomPageParameters{URL: "", State: CustomPageStateCustomized})
+	assert.ErrorIs(t, err, ErrMissingCustomPageURL)
+}
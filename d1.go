@@ -73,6 +73,10 @@ type QueryD1Response struct {
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-list-databases
 func (api *API) ListD1Databases(ctx context.Context, rc *ResourceContainer, params ListD1DatabasesParams) ([]D1Database, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []D1Database{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []D1Database{}, &ResultInfo{}, ErrMissingAccountID
 	}
@@ -115,6 +119,10 @@ func (api *API) ListD1Databases(ctx context.Context, rc *ResourceContainer, para
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-create-database
 func (api *API) CreateD1Database(ctx context.Context, rc *ResourceContainer, params CreateD1DatabaseParams) (D1Database, error) {
+	if rc.Level != AccountRouteLevel {
+		return D1Database{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return D1Database{}, ErrMissingAccountID
 	}
@@ -137,6 +145,10 @@ func (api *API) CreateD1Database(ctx context.Context, rc *ResourceContainer, par
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-delete-database
 func (api *API) DeleteD1Database(ctx context.Context, rc *ResourceContainer, databaseID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
@@ -155,6 +167,10 @@ func (api *API) DeleteD1Database(ctx context.Context, rc *ResourceContainer, dat
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-get-database
 func (api *API) GetD1Database(ctx context.Context, rc *ResourceContainer, databaseID string) (D1Database, error) {
+	if rc.Level != AccountRouteLevel {
+		return D1Database{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return D1Database{}, ErrMissingAccountID
 	}
@@ -177,6 +193,10 @@ func (api *API) GetD1Database(ctx context.Context, rc *ResourceContainer, databa
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-query-database
 func (api *API) QueryD1Database(ctx context.Context, rc *ResourceContainer, params QueryD1DatabaseParams) ([]D1Result, error) {
+	if rc.Level != AccountRouteLevel {
+		return []D1Result{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []D1Result{}, ErrMissingAccountID
 	}
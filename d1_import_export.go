@@ -0,0 +1,267 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // the upload protocol identifies dumps by MD5
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingD1ImportSQL = errors.New("required SQL dump to import missing")
+	ErrD1UploadMismatch   = errors.New("uploaded SQL dump does not match the local file")
+)
+
+// defaultD1PollInterval is how long to wait between checks of a running
+// export or import.
+const defaultD1PollInterval = time.Second
+
+// D1DumpOptions limits what is included in an export.
+type D1DumpOptions struct {
+	NoData   bool     `json:"no_data,omitempty"`
+	NoSchema bool     `json:"no_schema,omitempty"`
+	Tables   []string `json:"tables,omitempty"`
+}
+
+// D1ExportParams are the parameters for exporting a database.
+type D1ExportParams struct {
+	DumpOptions *D1DumpOptions
+
+	// PollInterval is the time between checks of the running export,
+	// defaulting to one second.
+	PollInterval time.Duration
+}
+
+// D1Export is a finished export. SignedURL can be used to download the SQL
+// dump for a limited time without further authentication.
+type D1Export struct {
+	Filename   string
+	SignedURL  string
+	AtBookmark string
+}
+
+// D1ImportParams are the parameters for importing a SQL dump into a database.
+type D1ImportParams struct {
+	SQL io.Reader
+
+	// PollInterval is the time between checks of the running import,
+	// defaulting to one second.
+	PollInterval time.Duration
+}
+
+// D1Import is a finished import.
+type D1Import struct {
+	NumQueries    int
+	FinalBookmark string
+	Meta          D1DatabaseMetadata
+}
+
+type d1ExportRequest struct {
+	OutputFormat    string         `json:"output_format"`
+	CurrentBookmark string         `json:"current_bookmark,omitempty"`
+	DumpOptions     *D1DumpOptions `json:"dump_options,omitempty"`
+}
+
+type d1ImportRequest struct {
+	Action          string `json:"action"`
+	Etag            string `json:"etag,omitempty"`
+	Filename        string `json:"filename,omitempty"`
+	CurrentBookmark string `json:"current_bookmark,omitempty"`
+}
+
+// d1Operation is the state of an asynchronous export or import.
+type d1Operation struct {
+	AtBookmark string   `json:"at_bookmark"`
+	Error      string   `json:"error"`
+	Messages   []string `json:"messages"`
+	Status     string   `json:"status"`
+	Type       string   `json:"type"`
+	UploadURL  string   `json:"upload_url"`
+	Filename   string   `json:"filename"`
+	Result     struct {
+		Filename      string             `json:"filename"`
+		SignedURL     string             `json:"signed_url"`
+		FinalBookmark string             `json:"final_bookmark"`
+		NumQueries    int                `json:"num_queries"`
+		Meta          D1DatabaseMetadata `json:"meta"`
+	} `json:"result"`
+}
+
+type d1OperationResponse struct {
+	Result d1Operation `json:"result"`
+	Response
+}
+
+// ExportD1Database starts an export of a database and polls it until the SQL
+// dump is ready for download.
+//
+// API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-export-database
+func (api *API) ExportD1Database(ctx context.Context, rc *ResourceContainer, databaseID string, params D1ExportParams) (D1Export, error) {
+	if rc.Level != AccountRouteLevel {
+		return D1Export{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return D1Export{}, ErrMissingAccountID
+	}
+
+	if databaseID == "" {
+		return D1Export{}, ErrMissingDatabaseID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/d1/database/%s/export", rc.Identifier, databaseID)
+	req := d1ExportRequest{OutputFormat: "polling", DumpOptions: params.DumpOptions}
+
+	op, err := api.pollD1Operation(ctx, params.PollInterval, func() (d1Operation, error) {
+		op, err := api.d1Operation(ctx, uri, req)
+		req.CurrentBookmark = op.AtBookmark
+		return op, err
+	})
+	if err != nil {
+		return D1Export{}, err
+	}
+
+	return D1Export{
+		Filename:   op.Result.Filename,
+		SignedURL:  op.Result.SignedURL,
+		AtBookmark: op.AtBookmark,
+	}, nil
+}
+
+// ImportD1Database uploads a SQL dump and executes it against a database,
+// polling until the import has finished. The dump is uploaded to a signed URL
+// returned by the API, identified by its MD5 hash.
+//
+// API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-import-database
+func (api *API) ImportD1Database(ctx context.Context, rc *ResourceContainer, databaseID string, params D1ImportParams) (D1Import, error) {
+	if rc.Level != AccountRouteLevel {
+		return D1Import{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return D1Import{}, ErrMissingAccountID
+	}
+
+	if databaseID == "" {
+		return D1Import{}, ErrMissingDatabaseID
+	}
+
+	if params.SQL == nil {
+		return D1Import{}, ErrMissingD1ImportSQL
+	}
+
+	sql, err := io.ReadAll(params.SQL)
+	if err != nil {
+		return D1Import{}, fmt.Errorf("error reading SQL dump: %w", err)
+	}
+
+	sum := md5.Sum(sql) //nolint:gosec
+	etag := hex.EncodeToString(sum[:])
+	uri := fmt.Sprintf("/accounts/%s/d1/database/%s/import", rc.Identifier, databaseID)
+
+	upload, err := api.d1Operation(ctx, uri, d1ImportRequest{Action: "init", Etag: etag})
+	if err != nil {
+		return D1Import{}, err
+	}
+
+	if upload.UploadURL != "" {
+		if err := api.uploadD1Dump(ctx, upload.UploadURL, etag, sql); err != nil {
+			return D1Import{}, err
+		}
+	}
+
+	req := d1ImportRequest{Action: "ingest", Etag: etag, Filename: upload.Filename}
+	op, err := api.pollD1Operation(ctx, params.PollInterval, func() (d1Operation, error) {
+		op, err := api.d1Operation(ctx, uri, req)
+		req = d1ImportRequest{Action: "poll", CurrentBookmark: op.AtBookmark}
+		return op, err
+	})
+	if err != nil {
+		return D1Import{}, err
+	}
+
+	return D1Import{
+		NumQueries:    op.Result.NumQueries,
+		FinalBookmark: op.Result.FinalBookmark,
+		Meta:          op.Result.Meta,
+	}, nil
+}
+
+func (api *API) d1Operation(ctx context.Context, uri string, params interface{}) (d1Operation, error) {
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return d1Operation{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r d1OperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return d1Operation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// pollD1Operation calls next until the operation is complete. The operation
+// status is either active, complete or error.
+func (api *API) pollD1Operation(ctx context.Context, interval time.Duration, next func() (d1Operation, error)) (d1Operation, error) {
+	if interval <= 0 {
+		interval = defaultD1PollInterval
+	}
+
+	for {
+		op, err := next()
+		if err != nil {
+			return d1Operation{}, err
+		}
+
+		switch op.Status {
+		case "complete":
+			return op, nil
+		case "error":
+			return d1Operation{}, errors.New(op.Error)
+		case "active":
+		default:
+			return d1Operation{}, fmt.Errorf("database operation returned an unexpected status: %s", op.Status)
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return d1Operation{}, fmt.Errorf("operation aborted while polling: %w", ctx.Err())
+		}
+	}
+}
+
+// uploadD1Dump uploads the SQL dump to the signed URL returned when
+// initialising an import and checks the stored object matches it.
+func (api *API) uploadD1Dump(ctx context.Context, uploadURL, etag string, sql []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, bytes.NewReader(sql))
+	if err != nil {
+		return fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+
+	resp, err := api.send(req)
+	if err != nil {
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("uploading SQL dump failed: %s", resp.Status)
+	}
+
+	if strings.Trim(resp.Header.Get("ETag"), `"`) != etag {
+		return ErrD1UploadMismatch
+	}
+
+	return nil
+}
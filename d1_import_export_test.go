@@ -0,0 +1,157 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testD1DumpSQL  = "CREATE TABLE users (id INTEGER PRIMARY KEY);\n"
+	testD1DumpEtag = "d62beed1f664fbaf52c348e042d5c1e6"
+)
+
+func TestExportD1Database(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/accounts/"+testAccountID+"/d1/database/"+testD1DatabaseID+"/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("content-type", "application/json")
+
+		polls++
+		if polls == 1 {
+			assert.JSONEq(t, `{"output_format": "polling", "dump_options": {"no_data": true}}`, string(body))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"success": true, "type": "export", "status": "active", "at_bookmark": "00000001-00000002"}}`)
+			return
+		}
+
+		assert.JSONEq(t, `{"output_format": "polling", "current_bookmark": "00000001-00000002", "dump_options": {"no_data": true}}`, string(body))
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "success": true,
+    "type": "export",
+    "status": "complete",
+    "at_bookmark": "00000001-00000002",
+    "result": {
+      "filename": "export.sql",
+      "signed_url": "https://d1-exports.example.com/export.sql?X-Amz-Signature=abc"
+    }
+  }
+}`)
+	})
+
+	want := D1Export{
+		Filename:   "export.sql",
+		SignedURL:  "https://d1-exports.example.com/export.sql?X-Amz-Signature=abc",
+		AtBookmark: "00000001-00000002",
+	}
+
+	actual, err := client.ExportD1Database(context.Background(), AccountIdentifier(testAccountID), testD1DatabaseID, D1ExportParams{
+		DumpOptions:  &D1DumpOptions{NoData: true},
+		PollInterval: time.Millisecond,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+	assert.Equal(t, 2, polls)
+}
+
+func TestExportD1Database_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/d1/database/"+testD1DatabaseID+"/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"success": false, "type": "export", "status": "error", "error": "database is too large to export"}}`)
+	})
+
+	_, err := client.ExportD1Database(context.Background(), AccountIdentifier(testAccountID), testD1DatabaseID, D1ExportParams{})
+	assert.EqualError(t, err, "database is too large to export")
+}
+
+func TestImportD1Database(t *testing.T) {
+	var paths []string
+	setup(WithDoer(doerFunc(func(r *http.Request) (*http.Response, error) {
+		paths = append(paths, r.URL.Path)
+		return http.DefaultClient.Do(r)
+	})))
+	defer teardown()
+
+	uploaded := false
+	mux.HandleFunc("/d1-imports/upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, testD1DumpSQL, string(body))
+		uploaded = true
+		w.Header().Set("ETag", `"`+testD1DumpEtag+`"`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/d1/database/"+testD1DatabaseID+"/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		var req map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("content-type", "application/json")
+
+		switch req["action"] {
+		case "init":
+			assert.Equal(t, testD1DumpEtag, req["etag"])
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"success": true, "filename": "%s.sql", "upload_url": "%s/d1-imports/upload"}}`, testD1DumpEtag, server.URL)
+		case "ingest":
+			assert.True(t, uploaded)
+			assert.Equal(t, testD1DumpEtag, req["etag"])
+			assert.Equal(t, testD1DumpEtag+".sql", req["filename"])
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"success": true, "type": "import", "status": "active", "at_bookmark": "00000003-00000004"}}`)
+		case "poll":
+			assert.Equal(t, "00000003-00000004", req["current_bookmark"])
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "success": true,
+    "type": "import",
+    "status": "complete",
+    "at_bookmark": "00000003-00000004",
+    "result": {
+      "final_bookmark": "00000003-00000005",
+      "num_queries": 1,
+      "meta": {"changes": 1, "rows_written": 1}
+    }
+  }
+}`)
+		default:
+			t.Errorf("unexpected action %q", req["action"])
+		}
+	})
+
+	want := D1Import{
+		NumQueries:    1,
+		FinalBookmark: "00000003-00000005",
+		Meta:          D1DatabaseMetadata{Changes: 1, RowsWritten: 1},
+	}
+
+	actual, err := client.ImportD1Database(context.Background(), AccountIdentifier(testAccountID), testD1DatabaseID, D1ImportParams{
+		SQL:          strings.NewReader(testD1DumpSQL),
+		PollInterval: time.Millisecond,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+	assert.Contains(t, paths, "/d1-imports/upload", "the dump should be uploaded through the Doer")
+
+	_, err = client.ImportD1Database(context.Background(), AccountIdentifier(testAccountID), testD1DatabaseID, D1ImportParams{})
+	assert.ErrorIs(t, err, ErrMissingD1ImportSQL)
+}
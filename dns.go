@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,6 +39,32 @@ type DNSRecord struct {
 	Tags       []string    `json:"tags,omitempty"`
 }
 
+// DNSRecordSRVData is the `Data` of an SRV record.
+type DNSRecordSRVData struct {
+	Service  string `json:"service,omitempty"`
+	Proto    string `json:"proto,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Priority uint16 `json:"priority"`
+	Weight   uint16 `json:"weight"`
+	Port     uint16 `json:"port"`
+	Target   string `json:"target"`
+}
+
+// DNSRecordCAAData is the `Data` of a CAA record.
+type DNSRecordCAAData struct {
+	Flags uint8  `json:"flags"`
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// DNSRecordHTTPSData is the `Data` of a HTTPS or SVCB record. Value holds the
+// service parameters, for example `alpn="h3,h2" ipv4hint="192.0.2.1"`.
+type DNSRecordHTTPSData struct {
+	Priority uint16 `json:"priority"`
+	Target   string `json:"target"`
+	Value    string `json:"value"`
+}
+
 // DNSRecordResponse represents the response from the DNS endpoint.
 type DNSRecordResponse struct {
 	Result DNSRecord `json:"result"`
@@ -201,6 +228,11 @@ type CreateDNSRecordParams struct {
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-create-dns-record
 func (api *API) CreateDNSRecord(ctx context.Context, rc *ResourceContainer, params CreateDNSRecordParams) (DNSRecord, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+
 	if rc.Identifier == "" {
 		return DNSRecord{}, ErrMissingZoneID
 	}
@@ -221,16 +253,32 @@ func (api *API) CreateDNSRecord(ctx context.Context, rc *ResourceContainer, para
 	return recordResp.Result, nil
 }
 
-// ListDNSRecords returns a slice of DNS records for the given zone identifier.
+// ListDNSRecords returns a slice of DNS records for the given zone identifier
+// along with the pagination details, including the total number of records,
+// of the last page fetched.
+//
+// Setting `params.Priority` filters the records client side so the returned
+// ResultInfo continues to describe the unfiltered pages.
+//
+// `params.PerPage` must be between 5 and 5000000.
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
 func (api *API) ListDNSRecords(ctx context.Context, rc *ResourceContainer, params ListDNSRecordsParams) ([]DNSRecord, *ResultInfo, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if rc.Identifier == "" {
 		return nil, nil, ErrMissingZoneID
 	}
 
 	params.Name = toUTS46ASCII(params.Name)
 
+	if err := checkPerPage(params.PerPage, dnsRecordsMinPerPage, dnsRecordsMaxPerPage); err != nil {
+		return nil, nil, err
+	}
+
 	autoPaginate := true
 	if params.PerPage >= 1 || params.Page >= 1 {
 		autoPaginate = false
@@ -258,7 +306,13 @@ func (api *API) ListDNSRecords(ctx context.Context, rc *ResourceContainer, param
 		if err != nil {
 			return []DNSRecord{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 		}
-		records = append(records, listResponse.Result...)
+		for _, record := range listResponse.Result {
+			// The API has no priority filter so it is applied here.
+			if params.Priority != nil && (record.Priority == nil || *record.Priority != *params.Priority) {
+				continue
+			}
+			records = append(records, record)
+		}
 		lastResultInfo = listResponse.ResultInfo
 		params.ResultInfo = listResponse.ResultInfo.Next()
 		if params.ResultInfo.Done() || !autoPaginate {
@@ -268,6 +322,92 @@ func (api *API) ListDNSRecords(ctx context.Context, rc *ResourceContainer, param
 	return records, &lastResultInfo, nil
 }
 
+// DNSRecordIterator yields the DNS records of a zone one at a time, fetching
+// the next page only once the records of the previous one have been consumed.
+type DNSRecordIterator struct {
+	api    *API
+	ctx    context.Context
+	rc     *ResourceContainer
+	params ListDNSRecordsParams
+
+	records []DNSRecord
+	record  DNSRecord
+	info    ResultInfo
+	done    bool
+	err     error
+}
+
+// IterateDNSRecords returns an iterator over the DNS records of a zone that
+// match params. Unlike ListDNSRecords it doesn't load every page up front,
+// which suits zones with very many records. params.Page and params.PerPage
+// set the first page and the page size.
+//
+//	it := api.IterateDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{})
+//	for it.Next() {
+//		record := it.Record()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+func (api *API) IterateDNSRecords(ctx context.Context, rc *ResourceContainer, params ListDNSRecordsParams) *DNSRecordIterator {
+	if params.PerPage < 1 {
+		params.PerPage = listDNSRecordsDefaultPageSize
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	return &DNSRecordIterator{api: api, ctx: ctx, rc: rc, params: params}
+}
+
+// Next advances the iterator to the next record, fetching the next page when
+// needed. It returns false once all records have been returned or an error
+// occurred, which is then available from Err.
+func (it *DNSRecordIterator) Next() bool {
+	for len(it.records) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		records, info, err := it.api.ListDNSRecords(it.ctx, it.rc, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.records = records
+		it.info = *info
+		it.params.ResultInfo = info.Next()
+		it.done = it.params.ResultInfo.Done()
+	}
+
+	it.record, it.records = it.records[0], it.records[1:]
+	return true
+}
+
+// Record returns the current record.
+func (it *DNSRecordIterator) Record() DNSRecord {
+	return it.record
+}
+
+// ResultInfo returns the pagination details of the most recently fetched
+// page, including the total number of records.
+func (it *DNSRecordIterator) ResultInfo() ResultInfo {
+	return it.info
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DNSRecordIterator) Err() error {
+	return it.err
+}
+
 // ErrMissingDNSRecordID is for when DNS record ID is needed but not given.
 var ErrMissingDNSRecordID = errors.New("required DNS record ID missing")
 
@@ -276,6 +416,11 @@ var ErrMissingDNSRecordID = errors.New("required DNS record ID missing")
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-dns-record-details
 func (api *API) GetDNSRecord(ctx context.Context, rc *ResourceContainer, recordID string) (DNSRecord, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+
 	if rc.Identifier == "" {
 		return DNSRecord{}, ErrMissingZoneID
 	}
@@ -301,6 +446,11 @@ func (api *API) GetDNSRecord(ctx context.Context, rc *ResourceContainer, recordI
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-update-dns-record
 func (api *API) UpdateDNSRecord(ctx context.Context, rc *ResourceContainer, params UpdateDNSRecordParams) (DNSRecord, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+
 	if rc.Identifier == "" {
 		return DNSRecord{}, ErrMissingZoneID
 	}
@@ -331,6 +481,11 @@ func (api *API) UpdateDNSRecord(ctx context.Context, rc *ResourceContainer, para
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-delete-dns-record
 func (api *API) DeleteDNSRecord(ctx context.Context, rc *ResourceContainer, recordID string) error {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return err
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingZoneID
 	}
@@ -351,10 +506,138 @@ func (api *API) DeleteDNSRecord(ctx context.Context, rc *ResourceContainer, reco
 	return nil
 }
 
+var (
+	// ErrMissingDNSRecordNameOrType is for when a DNS record can't be looked
+	// up because its name or type is missing.
+	ErrMissingDNSRecordNameOrType = errors.New("required DNS record name or type missing")
+
+	// ErrMultipleDNSRecords is for when more than one DNS record matches and
+	// the one to update has to be chosen by ID.
+	ErrMultipleDNSRecords = errors.New("multiple DNS records match")
+)
+
+// UpsertDNSRecord updates the DNS record with the same name and type, and for
+// MX and SRV records the same priority, or creates it when there is none. The
+// returned boolean reports whether the record was created.
+//
+// Names that aren't fully qualified, such as `www` or `@`, are qualified with
+// the zone name before looking up the record, as the API returns fully
+// qualified names. A trailing dot marks a name as fully qualified.
+//
+// When several records match, for example multiple A records for the same
+// name, ErrMultipleDNSRecords is returned and the ID of the record to update
+// has to be set. A record with an ID is always updated.
+func (api *API) UpsertDNSRecord(ctx context.Context, rc *ResourceContainer, record DNSRecord) (DNSRecord, bool, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return DNSRecord{}, false, err
+	}
+
+	if rc.Identifier == "" {
+		return DNSRecord{}, false, ErrMissingZoneID
+	}
+
+	if record.Name == "" || record.Type == "" {
+		return DNSRecord{}, false, ErrMissingDNSRecordNameOrType
+	}
+
+	if record.ID == "" {
+		record.Name, err = api.qualifyDNSRecordName(ctx, rc.Identifier, record.Name)
+		if err != nil {
+			return DNSRecord{}, false, err
+		}
+
+		params := ListDNSRecordsParams{Name: record.Name, Type: record.Type}
+		if record.Type == "MX" || record.Type == "SRV" {
+			params.Priority = record.Priority
+		}
+
+		existing, _, err := api.ListDNSRecords(ctx, rc, params)
+		if err != nil {
+			return DNSRecord{}, false, err
+		}
+
+		switch len(existing) {
+		case 0:
+			created, err := api.CreateDNSRecord(ctx, rc, CreateDNSRecordParams{
+				Type:     record.Type,
+				Name:     record.Name,
+				Content:  record.Content,
+				Data:     record.Data,
+				Priority: record.Priority,
+				TTL:      record.TTL,
+				Proxied:  record.Proxied,
+				Comment:  record.Comment,
+				Tags:     record.Tags,
+			})
+			if err != nil {
+				return DNSRecord{}, false, err
+			}
+
+			return created, true, nil
+		case 1:
+			record.ID = existing[0].ID
+		default:
+			return DNSRecord{}, false, fmt.Errorf("%w: %d %s records named %s, set the ID of the record to update", ErrMultipleDNSRecords, len(existing), record.Type, record.Name)
+		}
+	}
+
+	updated, err := api.UpdateDNSRecord(ctx, rc, UpdateDNSRecordParams{
+		ID:       record.ID,
+		Type:     record.Type,
+		Name:     record.Name,
+		Content:  record.Content,
+		Data:     record.Data,
+		Priority: record.Priority,
+		TTL:      record.TTL,
+		Proxied:  record.Proxied,
+		Comment:  &record.Comment,
+		Tags:     record.Tags,
+	})
+	if err != nil {
+		return DNSRecord{}, false, err
+	}
+
+	return updated, false, nil
+}
+
+// qualifyDNSRecordName returns the fully qualified form of a DNS record name
+// in the zone.
+func (api *API) qualifyDNSRecordName(ctx context.Context, zoneID, name string) (string, error) {
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, "."), nil
+	}
+
+	zone, err := api.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return "", err
+	}
+
+	return qualifyDNSName(name, zone.Name), nil
+}
+
+// qualifyDNSName qualifies a name relative to the zone, leaving names that
+// are already within the zone as they are.
+func qualifyDNSName(name, zoneName string) string {
+	switch lower := strings.ToLower(name); {
+	case name == "@":
+		return zoneName
+	case lower == strings.ToLower(zoneName), strings.HasSuffix(lower, "."+strings.ToLower(zoneName)):
+		return name
+	default:
+		return name + "." + zoneName
+	}
+}
+
 // ExportDNSRecords returns all DNS records for a zone in the BIND format.
 //
 // API reference: https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-export-dns-records
 func (api *API) ExportDNSRecords(ctx context.Context, rc *ResourceContainer, params ExportDNSRecordsParams) (string, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return "", err
+	}
+
 	if rc.Level != ZoneRouteLevel {
 		return "", ErrRequiredZoneLevelResourceContainer
 	}
@@ -384,6 +667,11 @@ func (api *API) ExportDNSRecords(ctx context.Context, rc *ResourceContainer, par
 //
 // API reference: https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-import-dns-records
 func (api *API) ImportDNSRecords(ctx context.Context, rc *ResourceContainer, params ImportDNSRecordsParams) error {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return err
+	}
+
 	if rc.Level != ZoneRouteLevel {
 		return ErrRequiredZoneLevelResourceContainer
 	}
@@ -408,7 +696,7 @@ func (api *API) ImportDNSRecords(ctx context.Context, rc *ResourceContainer, par
 		"Content-Type": {"multipart/form-data; boundary=------------------------BOUNDARY"},
 	}
 
-	_, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, nonProxiedReqBody, multipartUploadHeaders)
+	_, err = api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, nonProxiedReqBody, multipartUploadHeaders)
 	if err != nil {
 		return err
 	}
@@ -423,3 +711,86 @@ func (api *API) ImportDNSRecords(ctx context.Context, rc *ResourceContainer, par
 
 	return nil
 }
+
+// dnsRecordsBatchSize is the maximum number of changes sent in a single
+// request to the batch endpoint.
+const dnsRecordsBatchSize = 200
+
+// DNSRecordMetadata is the comment and tags of a DNS record.
+type DNSRecordMetadata struct {
+	// Comment replaces the comment of the record when set. StringPtr("")
+	// removes it.
+	Comment *string
+	// Tags replaces the tags of the record when not nil. An empty slice
+	// removes all tags.
+	Tags []string
+}
+
+type dnsRecordsBatchRequest struct {
+	Patches []map[string]interface{} `json:"patches"`
+}
+
+// UpdateDNSRecordsMetadata changes only the comment and tags of DNS records,
+// keyed by record ID, without touching their content. Changes are sent to the
+// batch endpoint in groups of up to 200 records; each group is applied
+// atomically. The returned map holds the outcome for every record ID, nil on
+// success, and the error is that of the first group that failed.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-batch-dns-records
+func (api *API) UpdateDNSRecordsMetadata(ctx context.Context, rc *ResourceContainer, updates map[string]DNSRecordMetadata) (map[string]error, error) {
+	rc, err := api.resolveResourceContainer(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	ids := make([]string, 0, len(updates))
+	for id := range updates {
+		if id == "" {
+			return nil, ErrMissingDNSRecordID
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	uri := fmt.Sprintf("/zones/%s/dns_records/batch", rc.Identifier)
+	results := make(map[string]error, len(ids))
+	var firstErr error
+
+	for start := 0; start < len(ids); start += dnsRecordsBatchSize {
+		end := start + dnsRecordsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch := dnsRecordsBatchRequest{Patches: make([]map[string]interface{}, 0, end-start)}
+		for _, id := range ids[start:end] {
+			patch := map[string]interface{}{"id": id}
+			if updates[id].Comment != nil {
+				patch["comment"] = *updates[id].Comment
+			}
+			if updates[id].Tags != nil {
+				patch["tags"] = updates[id].Tags
+			}
+			batch.Patches = append(batch.Patches, patch)
+		}
+
+		_, err := api.makeRequestContext(ctx, http.MethodPost, uri, batch)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		for _, id := range ids[start:end] {
+			results[id] = err
+		}
+	}
+
+	return results, firstErr
+}
@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// DNSAnalyticsOptions represents the dimension, metric and time range
+// selection for the DNS analytics report endpoints.
+//
+// Filters uses the Cloudflare analytics filter syntax, for example
+// `responseCode==NXDOMAIN;queryName==example.com`.
+type DNSAnalyticsOptions struct {
+	Dimensions []string   `url:"dimensions,omitempty" del:","`
+	Metrics    []string   `url:"metrics,omitempty" del:","`
+	Sort       []string   `url:"sort,omitempty" del:","`
+	Filters    string     `url:"filters,omitempty"`
+	Since      *time.Time `url:"since,omitempty"`
+	Until      *time.Time `url:"until,omitempty"`
+	Limit      int        `url:"limit,omitempty"`
+
+	// TimeDelta is the unit of time to group the results by. It is only
+	// used by the time series report.
+	TimeDelta string `url:"time_delta,omitempty"`
+}
+
+// DNSAnalyticsQuery is the query the DNS analytics report was generated
+// from, as echoed back by the API.
+type DNSAnalyticsQuery struct {
+	Dimensions []string   `json:"dimensions"`
+	Metrics    []string   `json:"metrics"`
+	Sort       []string   `json:"sort"`
+	Filters    string     `json:"filters"`
+	Since      *time.Time `json:"since"`
+	Until      *time.Time `json:"until"`
+	Limit      int        `json:"limit"`
+	TimeDelta  string     `json:"time_delta,omitempty"`
+}
+
+// DNSAnalyticsRow is a single row of a DNS analytics table report. Metrics are
+// in the same order as requested.
+type DNSAnalyticsRow struct {
+	Dimensions []string  `json:"dimensions"`
+	Metrics    []float64 `json:"metrics"`
+}
+
+// DNSAnalyticsReport is the table report for DNS analytics.
+type DNSAnalyticsReport struct {
+	Rows    int                `json:"rows"`
+	Data    []DNSAnalyticsRow  `json:"data"`
+	DataLag float64            `json:"data_lag"`
+	Totals  map[string]float64 `json:"totals"`
+	Min     map[string]float64 `json:"min"`
+	Max     map[string]float64 `json:"max"`
+	Query   DNSAnalyticsQuery  `json:"query"`
+}
+
+// DNSAnalyticsTimeSeriesRow is a single row of a DNS analytics time series
+// report. Each entry of Metrics holds the values for one metric, with one
+// value per time interval.
+type DNSAnalyticsTimeSeriesRow struct {
+	Dimensions []string    `json:"dimensions"`
+	Metrics    [][]float64 `json:"metrics"`
+}
+
+// DNSAnalyticsReportByTime is the time series report for DNS analytics.
+type DNSAnalyticsReportByTime struct {
+	Rows          int                         `json:"rows"`
+	Data          []DNSAnalyticsTimeSeriesRow `json:"data"`
+	DataLag       float64                     `json:"data_lag"`
+	Totals        map[string]float64          `json:"totals"`
+	Min           map[string]float64          `json:"min"`
+	Max           map[string]float64          `json:"max"`
+	Query         DNSAnalyticsQuery           `json:"query"`
+	TimeIntervals [][]time.Time               `json:"time_intervals"`
+}
+
+// dnsAnalyticsReportResponse represents a DNS analytics table report response.
+type dnsAnalyticsReportResponse struct {
+	Response
+	Result DNSAnalyticsReport `json:"result"`
+}
+
+// dnsAnalyticsReportByTimeResponse represents a DNS analytics time series
+// report response.
+type dnsAnalyticsReportByTimeResponse struct {
+	Response
+	Result DNSAnalyticsReportByTime `json:"result"`
+}
+
+// ZoneDNSAnalytics retrieves a list of summarised aggregate metrics over a
+// given time period for the zone's DNS queries.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dns-analytics-table
+func (api *API) ZoneDNSAnalytics(ctx context.Context, rc *ResourceContainer, opts DNSAnalyticsOptions) (DNSAnalyticsReport, error) {
+	if rc.Level != ZoneRouteLevel {
+		return DNSAnalyticsReport{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return DNSAnalyticsReport{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/dns_analytics/report", rc.Identifier), opts)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DNSAnalyticsReport{}, err
+	}
+
+	response := dnsAnalyticsReportResponse{}
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return DNSAnalyticsReport{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return response.Result, nil
+}
+
+// ZoneDNSAnalyticsByTime retrieves a list of aggregate metrics grouped by time
+// interval for the zone's DNS queries.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dns-analytics-by-time
+func (api *API) ZoneDNSAnalyticsByTime(ctx context.Context, rc *ResourceContainer, opts DNSAnalyticsOptions) (DNSAnalyticsReportByTime, error) {
+	if rc.Level != ZoneRouteLevel {
+		return DNSAnalyticsReportByTime{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return DNSAnalyticsReportByTime{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/dns_analytics/report/bytime", rc.Identifier), opts)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DNSAnalyticsReportByTime{}, err
+	}
+
+	response := dnsAnalyticsReportByTimeResponse{}
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return DNSAnalyticsReportByTime{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return response.Result, nil
+}
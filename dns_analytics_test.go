@@ -0,0 +1,179 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneDNSAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since, _ := time.Parse(time.RFC3339, "2023-11-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-11-02T00:00:00Z")
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "queryName,responseCode", r.URL.Query().Get("dimensions"))
+		assert.Equal(t, "queryCount", r.URL.Query().Get("metrics"))
+		assert.Equal(t, "responseCode==NXDOMAIN", r.URL.Query().Get("filters"))
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+		assert.Equal(t, until.Format(time.RFC3339), r.URL.Query().Get("until"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"rows": 1,
+			"data": [
+			  {
+				"dimensions": ["bad.example.com", "NXDOMAIN"],
+				"metrics": [1234]
+			  }
+			],
+			"data_lag": 60,
+			"totals": {"queryCount": 1234},
+			"min": {"queryCount": 1234},
+			"max": {"queryCount": 1234},
+			"query": {
+			  "dimensions": ["queryName", "responseCode"],
+			  "metrics": ["queryCount"],
+			  "filters": "responseCode==NXDOMAIN",
+			  "since": "2023-11-01T00:00:00Z",
+			  "until": "2023-11-02T00:00:00Z",
+			  "limit": 10
+			}
+		  },
+		  "success": true,
+		  "errors": [],
+		  "messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_analytics/report", handler)
+
+	want := DNSAnalyticsReport{
+		Rows: 1,
+		Data: []DNSAnalyticsRow{
+			{
+				Dimensions: []string{"bad.example.com", "NXDOMAIN"},
+				Metrics:    []float64{1234},
+			},
+		},
+		DataLag: 60,
+		Totals:  map[string]float64{"queryCount": 1234},
+		Min:     map[string]float64{"queryCount": 1234},
+		Max:     map[string]float64{"queryCount": 1234},
+		Query: DNSAnalyticsQuery{
+			Dimensions: []string{"queryName", "responseCode"},
+			Metrics:    []string{"queryCount"},
+			Filters:    "responseCode==NXDOMAIN",
+			Since:      &since,
+			Until:      &until,
+			Limit:      10,
+		},
+	}
+
+	actual, err := client.ZoneDNSAnalytics(context.Background(), ZoneIdentifier(testZoneID), DNSAnalyticsOptions{
+		Dimensions: []string{"queryName", "responseCode"},
+		Metrics:    []string{"queryCount"},
+		Filters:    "responseCode==NXDOMAIN",
+		Since:      &since,
+		Until:      &until,
+		Limit:      10,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ZoneDNSAnalytics(context.Background(), AccountIdentifier(testAccountID), DNSAnalyticsOptions{})
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+}
+
+func TestZoneDNSAnalyticsByTime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since, _ := time.Parse(time.RFC3339, "2023-11-01T00:00:00Z")
+	middle, _ := time.Parse(time.RFC3339, "2023-11-01T01:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-11-01T02:00:00Z")
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "responseCode", r.URL.Query().Get("dimensions"))
+		assert.Equal(t, "hour", r.URL.Query().Get("time_delta"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"rows": 1,
+			"data": [
+			  {
+				"dimensions": ["NOERROR"],
+				"metrics": [[10, 20]]
+			  }
+			],
+			"data_lag": 0,
+			"totals": {"queryCount": 30},
+			"min": {"queryCount": 10},
+			"max": {"queryCount": 20},
+			"query": {
+			  "dimensions": ["responseCode"],
+			  "metrics": ["queryCount"],
+			  "since": "2023-11-01T00:00:00Z",
+			  "until": "2023-11-01T02:00:00Z",
+			  "time_delta": "hour"
+			},
+			"time_intervals": [
+			  ["2023-11-01T00:00:00Z", "2023-11-01T01:00:00Z"],
+			  ["2023-11-01T01:00:00Z", "2023-11-01T02:00:00Z"]
+			]
+		  },
+		  "success": true,
+		  "errors": [],
+		  "messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_analytics/report/bytime", handler)
+
+	want := DNSAnalyticsReportByTime{
+		Rows: 1,
+		Data: []DNSAnalyticsTimeSeriesRow{
+			{
+				Dimensions: []string{"NOERROR"},
+				Metrics:    [][]float64{{10, 20}},
+			},
+		},
+		Totals: map[string]float64{"queryCount": 30},
+		Min:    map[string]float64{"queryCount": 10},
+		Max:    map[string]float64{"queryCount": 20},
+		Query: DNSAnalyticsQuery{
+			Dimensions: []string{"responseCode"},
+			Metrics:    []string{"queryCount"},
+			Since:      &since,
+			Until:      &until,
+			TimeDelta:  "hour",
+		},
+		TimeIntervals: [][]time.Time{
+			{since, middle},
+			{middle, until},
+		},
+	}
+
+	actual, err := client.ZoneDNSAnalyticsByTime(context.Background(), ZoneIdentifier(testZoneID), DNSAnalyticsOptions{
+		Dimensions: []string{"responseCode"},
+		Metrics:    []string{"queryCount"},
+		Since:      &since,
+		Until:      &until,
+		TimeDelta:  "hour",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -3,7 +3,10 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -416,6 +419,58 @@ func TestListDNSRecordsPagination(t *testing.T) {
 	}
 }
 
+func TestIterateDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pages = append(pages, strconv.Itoa(page))
+		assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+
+		var records []string
+		for i := (page-1)*5 + 1; i <= 7 && i <= page*5; i++ {
+			records = append(records, fmt.Sprintf(`{"id": "%d", "type": "A", "name": "%d.example.com", "content": "198.51.100.%d"}`, i, i, i))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {"count": %d, "page": %d, "per_page": 5, "total_count": 7, "total_pages": 2}
+		}`, strings.Join(records, ","), len(records), page)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", handler)
+
+	it := client.IterateDNSRecords(context.Background(), ZoneIdentifier(testZoneID), ListDNSRecordsParams{ResultInfo: ResultInfo{PerPage: 5}})
+	assert.Empty(t, pages, "no page is fetched before Next is called")
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Record().ID)
+		if len(ids) == 5 {
+			assert.Equal(t, []string{"1"}, pages, "the second page is only fetched once the first is consumed")
+			assert.Equal(t, 7, it.ResultInfo().Total)
+		}
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7"}, ids)
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.False(t, it.Next())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it = client.IterateDNSRecords(ctx, ZoneIdentifier(testZoneID), ListDNSRecordsParams{ResultInfo: ResultInfo{PerPage: 5}})
+	require.True(t, it.Next())
+	cancel()
+	for it.Next() {
+	}
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
+
 func TestGetDNSRecord(t *testing.T) {
 	setup()
 	defer teardown()
@@ -677,6 +732,89 @@ func TestUpdateDNSRecord_KeepComment(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpsertDNSRecord(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const recordID = "372e67954025e0ba6aaa6d586b9e0b59"
+	existing := map[string]string{
+		"www.example.com": `[{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "www.example.com", "content": "198.51.100.4"}]`,
+		"new.example.com": `[]`,
+		"api.example.com": `[
+			{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "api.example.com", "content": "198.51.100.4"},
+			{"id": "2d0b3dc2c3c44fbc95bab4d0ba54a0d8", "type": "A", "name": "api.example.com", "content": "198.51.100.5"}
+		]`,
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com"}}`, testZoneID)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"type": "A", "name": "new.example.com", "content": "198.51.100.6", "ttl": 120, "created_on": "0001-01-01T00:00:00Z", "modified_on": "0001-01-01T00:00:00Z"}`, string(body))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "f5cb9f2cb0c4426d8ac0e02f8b0e0e8a", "type": "A", "name": "new.example.com", "content": "198.51.100.6", "ttl": 120}}`)
+			return
+		}
+
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "A", r.URL.Query().Get("type"))
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 100, "count": 1, "total_count": 1, "total_pages": 1}}`, existing[r.URL.Query().Get("name")])
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/"+recordID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"type": "A", "name": "www.example.com", "content": "198.51.100.7", "ttl": 120, "comment": "", "tags": null}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "type": "A", "name": "www.example.com", "content": "198.51.100.7", "ttl": 120}}`, recordID)
+	})
+
+	record, created, err := client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Type: "A", Name: "www.example.com", Content: "198.51.100.7", TTL: 120})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, recordID, record.ID)
+
+	record, created, err = client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Type: "A", Name: "new.example.com", Content: "198.51.100.6", TTL: 120})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "f5cb9f2cb0c4426d8ac0e02f8b0e0e8a", record.ID)
+
+	_, _, err = client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Type: "A", Name: "api.example.com", Content: "198.51.100.7"})
+	assert.ErrorIs(t, err, ErrMultipleDNSRecords)
+
+	// Relative names are looked up by their fully qualified name.
+	record, created, err = client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Type: "A", Name: "www", Content: "198.51.100.7", TTL: 120})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, recordID, record.ID)
+
+	record, created, err = client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Type: "A", Name: "www.example.com.", Content: "198.51.100.7", TTL: 120})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, recordID, record.ID)
+
+	_, _, err = client.UpsertDNSRecord(context.Background(), ZoneIdentifier(testZoneID), DNSRecord{Name: "www.example.com"})
+	assert.ErrorIs(t, err, ErrMissingDNSRecordNameOrType)
+}
+
+func TestQualifyDNSName(t *testing.T) {
+	for name, want := range map[string]string{
+		"www":             "www.example.com",
+		"@":               "example.com",
+		"example.com":     "example.com",
+		"WWW.Example.com": "WWW.Example.com",
+		"www.example.org": "www.example.org.example.com",
+		"_dmarc.mail":     "_dmarc.mail.example.com",
+	} {
+		assert.Equal(t, want, qualifyDNSName(name, "example.com"), name)
+	}
+}
+
 func TestDeleteDNSRecord(t *testing.T) {
 	setup()
 	defer teardown()
@@ -708,3 +846,224 @@ func TestDeleteDNSRecord(t *testing.T) {
 	err = client.DeleteDNSRecord(context.Background(), ZoneIdentifier(testZoneID), dnsRecordID)
 	require.NoError(t, err)
 }
+
+func TestListDNSRecords_DefaultResourceContainer(t *testing.T) {
+	setup(WithDefaultResourceContainer(ZoneIdentifier(testZoneID)))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "example.com", "content": "198.51.100.4"}],
+			"result_info": {"count": 1, "page": 1, "per_page": 100, "total_count": 1, "total_pages": 1}
+		}`)
+	})
+
+	records, _, err := client.ListDNSRecords(context.Background(), nil, ListDNSRecordsParams{})
+	if assert.NoError(t, err) {
+		assert.Len(t, records, 1)
+	}
+
+	ctx := WithResourceContainer(context.Background(), ZoneIdentifier("other"))
+	_, err = client.GetDNSRecord(ctx, nil, "")
+	assert.ErrorIs(t, err, ErrMissingDNSRecordID)
+
+	api, _ := New("deadbeef", "cloudflare@example.org")
+	_, _, err = api.ListDNSRecords(context.Background(), nil, ListDNSRecordsParams{})
+	assert.ErrorIs(t, err, ErrMissingResourceContainer)
+}
+
+func TestListDNSRecords_MXAndSRVRoundTrip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b59",
+					"type": "MX",
+					"name": "example.com",
+					"content": "mx1.example.com",
+					"priority": 10,
+					"proxiable": false,
+					"proxied": false,
+					"ttl": 3600,
+					"comment": "primary mail",
+					"tags": ["team:mail"],
+					"created_on": "2014-01-01T05:20:00Z",
+					"modified_on": "2014-02-01T05:20:00Z"
+				},
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b60",
+					"type": "MX",
+					"name": "example.com",
+					"content": "mx2.example.com",
+					"priority": 20,
+					"proxied": false,
+					"ttl": 3600
+				},
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b61",
+					"type": "SRV",
+					"name": "_sip._tcp.example.com",
+					"content": "10\t5060\tsip.example.com",
+					"priority": 10,
+					"proxied": false,
+					"ttl": 1,
+					"data": {"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com"}
+				}
+			],
+			"result_info": {"count": 3, "page": 1, "per_page": 100, "total_count": 3, "total_pages": 1}
+		}`)
+	})
+
+	records, resultInfo, err := client.ListDNSRecords(context.Background(), ZoneIdentifier(testZoneID), ListDNSRecordsParams{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, 3, resultInfo.Total)
+
+	mx := records[0]
+	assert.Equal(t, uint16(10), *mx.Priority)
+	assert.Equal(t, 3600, mx.TTL)
+	assert.False(t, *mx.Proxied)
+	assert.Equal(t, "primary mail", mx.Comment)
+	assert.Equal(t, []string{"team:mail"}, mx.Tags)
+	assert.Equal(t, time.Date(2014, 1, 1, 5, 20, 0, 0, time.UTC), mx.CreatedOn)
+	assert.Equal(t, time.Date(2014, 2, 1, 5, 20, 0, 0, time.UTC), mx.ModifiedOn)
+
+	srv := records[2]
+	assert.Equal(t, uint16(10), *srv.Priority)
+	assert.Equal(t, 1, srv.TTL)
+	assert.Equal(t, map[string]interface{}{"priority": float64(10), "weight": float64(5), "port": float64(5060), "target": "sip.example.com"}, srv.Data)
+
+	// The records survive a round trip through JSON unchanged.
+	b, err := json.Marshal(records)
+	require.NoError(t, err)
+	var roundTripped []DNSRecord
+	require.NoError(t, json.Unmarshal(b, &roundTripped))
+	assert.Equal(t, records, roundTripped)
+
+	// Priority is filtered client side as the API doesn't support it.
+	records, resultInfo, err = client.ListDNSRecords(context.Background(), ZoneIdentifier(testZoneID), ListDNSRecordsParams{Priority: Uint16Ptr(20)})
+	require.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "mx2.example.com", records[0].Content)
+	}
+	assert.Equal(t, 3, resultInfo.Total)
+}
+
+func TestCreateDNSRecord_TypedData(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tests := []struct {
+		name string
+		data interface{}
+		want string
+	}{
+		{
+			name: "SRV",
+			data: DNSRecordSRVData{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"},
+			want: `{"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com"}`,
+		},
+		{
+			name: "CAA",
+			data: DNSRecordCAAData{Flags: 0, Tag: "issue", Value: "letsencrypt.org"},
+			want: `{"flags": 0, "tag": "issue", "value": "letsencrypt.org"}`,
+		},
+		{
+			name: "HTTPS",
+			data: DNSRecordHTTPSData{Priority: 1, Target: ".", Value: `alpn="h3,h2"`},
+			want: `{"priority": 1, "target": ".", "value": "alpn=\"h3,h2\""}`,
+		},
+	}
+
+	var received map[string]json.RawMessage
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		received = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59"}}`)
+	})
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.CreateDNSRecord(context.Background(), ZoneIdentifier(testZoneID), CreateDNSRecordParams{
+				Type: tc.name,
+				Name: "example.com",
+				Data: tc.data,
+			})
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(received["data"]))
+		})
+	}
+}
+
+func TestUpdateDNSRecordsMetadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/batch", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"patches": [
+			{"id": "372e67954025e0ba6aaa6d586b9e0b59", "comment": "owned by platform", "tags": ["team:platform"]},
+			{"id": "372e67954025e0ba6aaa6d586b9e0b60", "tags": []}
+		]}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"patches": []}}`)
+	})
+
+	results, err := client.UpdateDNSRecordsMetadata(context.Background(), ZoneIdentifier(testZoneID), map[string]DNSRecordMetadata{
+		"372e67954025e0ba6aaa6d586b9e0b59": {Comment: StringPtr("owned by platform"), Tags: []string{"team:platform"}},
+		"372e67954025e0ba6aaa6d586b9e0b60": {Tags: []string{}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]error{
+			"372e67954025e0ba6aaa6d586b9e0b59": nil,
+			"372e67954025e0ba6aaa6d586b9e0b60": nil,
+		}, results)
+	}
+}
+
+func TestUpdateDNSRecordsMetadata_PartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/batch", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("content-type", "application/json")
+		if requests == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 81044, "message": "Record does not exist."}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"patches": []}}`)
+	})
+
+	updates := make(map[string]DNSRecordMetadata, dnsRecordsBatchSize+1)
+	for i := 0; i <= dnsRecordsBatchSize; i++ {
+		updates[fmt.Sprintf("record-%03d", i)] = DNSRecordMetadata{Comment: StringPtr("owned by platform")}
+	}
+
+	results, err := client.UpdateDNSRecordsMetadata(context.Background(), ZoneIdentifier(testZoneID), updates)
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+	assert.Len(t, results, dnsRecordsBatchSize+1)
+	assert.NoError(t, results["record-000"])
+	assert.Error(t, results[fmt.Sprintf("record-%03d", dnsRecordsBatchSize)])
+}
@@ -32,6 +32,8 @@ const (
 	errInvalidResourceContainerAccess        = "requested resource container (%q) is not supported for this endpoint"
 	errRequiredAccountLevelResourceContainer = "this endpoint requires using an account level resource container and identifiers"
 	errRequiredZoneLevelResourceContainer    = "this endpoint requires using a zone level resource container and identifiers"
+	errMissingResourceContainer              = "resource container is nil and no default resource container has been configured"
+	errInvalidResourceLevel                  = "resource container level is not supported for this endpoint"
 )
 
 var (
@@ -43,10 +45,30 @@ var (
 	ErrAccountIDAndZoneIDAreMutuallyExclusive = errors.New(errAccountIDAndZoneIDAreMutuallyExclusive)
 	ErrMissingResourceIdentifier              = errors.New(errMissingResourceIdentifier)
 
-	ErrRequiredAccountLevelResourceContainer = errors.New(errRequiredAccountLevelResourceContainer)
-	ErrRequiredZoneLevelResourceContainer    = errors.New(errRequiredZoneLevelResourceContainer)
+	// ErrInvalidResourceLevel is matched by the errors returned when a
+	// resource container of the wrong level is passed to a method that only
+	// supports accounts or zones.
+	ErrInvalidResourceLevel = errors.New(errInvalidResourceLevel)
+
+	ErrRequiredAccountLevelResourceContainer error = &resourceLevelError{errRequiredAccountLevelResourceContainer}
+	ErrRequiredZoneLevelResourceContainer    error = &resourceLevelError{errRequiredZoneLevelResourceContainer}
+	ErrMissingResourceContainer                    = errors.New(errMissingResourceContainer)
 )
 
+// resourceLevelError is an error about the level of a resource container
+// that matches ErrInvalidResourceLevel.
+type resourceLevelError struct {
+	msg string
+}
+
+func (e *resourceLevelError) Error() string {
+	return e.msg
+}
+
+func (e *resourceLevelError) Is(target error) bool {
+	return target == ErrInvalidResourceLevel
+}
+
 type ErrorType string
 
 const (
@@ -389,3 +411,72 @@ func (e *Error) ErrorMessageContains(s string) bool {
 	}
 	return false
 }
+
+// Error codes returned by the API that are common across endpoints. The full
+// list is in the documentation of each endpoint.
+const (
+	ErrorCodeRateLimited             = 971
+	ErrorCodeZoneAlreadyExists       = 1061
+	ErrorCodeCustomHostnameDuplicate = 1406
+	ErrorCodeInvalidObjectIdentifier = 7003
+	ErrorCodeUnknownAuthKey          = 9103
+	ErrorCodeMissingAuthHeaders      = 9106
+	ErrorCodeInvalidAccessToken      = 9109
+	ErrorCodeAuthenticationError     = 10000
+	ErrorCodeDNSRecordNotFound       = 81044
+	ErrorCodeDNSRecordHostConflict   = 81053
+	ErrorCodeDNSRecordAlreadyExists  = 81057
+)
+
+var (
+	notFoundErrorCodes      = []int{ErrorCodeInvalidObjectIdentifier, ErrorCodeDNSRecordNotFound}
+	rateLimitedErrorCodes   = []int{ErrorCodeRateLimited}
+	authErrorCodes          = []int{ErrorCodeUnknownAuthKey, ErrorCodeMissingAuthHeaders, ErrorCodeInvalidAccessToken, ErrorCodeAuthenticationError}
+	alreadyExistsErrorCodes = []int{ErrorCodeZoneAlreadyExists, ErrorCodeDNSRecordHostConflict, ErrorCodeDNSRecordAlreadyExists, ErrorCodeCustomHostnameDuplicate}
+)
+
+// HasErrorCode returns whether err, or any error it wraps, is an API error
+// that includes one of the codes.
+func HasErrorCode(err error, codes ...int) bool {
+	var apiErr interface{ ErrorCodes() []int }
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, errCode := range apiErr.ErrorCodes() {
+		for _, code := range codes {
+			if errCode == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsNotFound returns whether err is caused by a resource that doesn't exist.
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr) || HasErrorCode(err, notFoundErrorCodes...)
+}
+
+// IsRateLimited returns whether err is caused by too many requests, in which
+// case the request can be retried later.
+func IsRateLimited(err error) bool {
+	var ratelimitErr *RatelimitError
+	return errors.As(err, &ratelimitErr) || HasErrorCode(err, rateLimitedErrorCodes...)
+}
+
+// IsAuthError returns whether err is caused by missing or invalid
+// credentials, or credentials without access to the resource.
+func IsAuthError(err error) bool {
+	var authenticationErr *AuthenticationError
+	var authorizationErr *AuthorizationError
+	return errors.As(err, &authenticationErr) || errors.As(err, &authorizationErr) || HasErrorCode(err, authErrorCodes...)
+}
+
+// IsAlreadyExists returns whether err is caused by creating a resource that
+// already exists.
+func IsAlreadyExists(err error) bool {
+	return HasErrorCode(err, alreadyExistsErrorCodes...)
+}
@@ -1,6 +1,9 @@
 package cloudflare
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,3 +53,53 @@ func TestError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestErrInvalidResourceLevel(t *testing.T) {
+	assert.ErrorIs(t, ErrRequiredAccountLevelResourceContainer, ErrInvalidResourceLevel)
+	assert.ErrorIs(t, ErrRequiredZoneLevelResourceContainer, ErrInvalidResourceLevel)
+	assert.NotErrorIs(t, ErrRequiredAccountLevelResourceContainer, ErrRequiredZoneLevelResourceContainer)
+	assert.NotErrorIs(t, ErrMissingAccountID, ErrInvalidResourceLevel)
+
+	setup()
+	defer teardown()
+
+	_, err := client.GetQueue(context.Background(), ZoneIdentifier(testZoneID), "example-queue")
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+	assert.ErrorIs(t, err, ErrInvalidResourceLevel)
+}
+
+func TestErrorPredicates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 81057, "message": "Record already exists."}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.CreateDNSRecord(context.Background(), ZoneIdentifier(testZoneID), CreateDNSRecordParams{Type: "A", Name: "example.com", Content: "192.0.2.1"})
+	assert.True(t, IsAlreadyExists(fmt.Errorf("reconcile: %w", err)))
+	assert.True(t, HasErrorCode(err, ErrorCodeDNSRecordAlreadyExists))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+	assert.False(t, IsAuthError(err))
+
+	notFound := &NotFoundError{cloudflareError: &Error{StatusCode: http.StatusNotFound}}
+	assert.True(t, IsNotFound(notFound))
+
+	ratelimited := &RatelimitError{cloudflareError: &Error{StatusCode: http.StatusTooManyRequests}}
+	assert.True(t, IsRateLimited(ratelimited))
+
+	throttled := &RequestError{cloudflareError: &Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{ErrorCodeRateLimited}}}
+	assert.True(t, IsRateLimited(throttled))
+
+	forbidden := &AuthenticationError{cloudflareError: &Error{StatusCode: http.StatusForbidden}}
+	assert.True(t, IsAuthError(forbidden))
+
+	invalidToken := &RequestError{cloudflareError: &Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{ErrorCodeInvalidAccessToken}}}
+	assert.True(t, IsAuthError(invalidToken))
+
+	assert.False(t, IsNotFound(ErrMissingZoneID))
+	assert.False(t, HasErrorCode(nil, ErrorCodeRateLimited))
+}
@@ -6,12 +6,151 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"unicode"
 
 	"github.com/goccy/go-json"
 )
 
 var ErrNotEnoughFilterIDsProvided = errors.New("at least one filter ID must be provided.")
 
+// filterExpressionFields contains the fields of the Rules language that
+// `ValidateFilterExpressionSyntax` accepts by default.
+//
+// https://developers.cloudflare.com/ruleset-engine/rules-language/fields/
+var filterExpressionFields = map[string]bool{
+	"cf.api_gateway.auth_id_present":                       true,
+	"cf.api_gateway.fallthrough_detected":                  true,
+	"cf.api_gateway.request_violates_schema":               true,
+	"cf.bot_management.corporate_proxy":                    true,
+	"cf.bot_management.detection_ids":                      true,
+	"cf.bot_management.ja3_hash":                           true,
+	"cf.bot_management.ja4":                                true,
+	"cf.bot_management.js_detection.passed":                true,
+	"cf.bot_management.score":                              true,
+	"cf.bot_management.static_resource":                    true,
+	"cf.bot_management.verified_bot":                       true,
+	"cf.client.bot":                                        true,
+	"cf.colo.id":                                           true,
+	"cf.colo.region":                                       true,
+	"cf.edge.server_ip":                                    true,
+	"cf.edge.server_port":                                  true,
+	"cf.hostname.metadata":                                 true,
+	"cf.metal.id":                                          true,
+	"cf.random_seed":                                       true,
+	"cf.ray_id":                                            true,
+	"cf.threat_score":                                      true,
+	"cf.tls_client_auth.cert_fingerprint_sha1":             true,
+	"cf.tls_client_auth.cert_fingerprint_sha256":           true,
+	"cf.tls_client_auth.cert_issuer_dn":                    true,
+	"cf.tls_client_auth.cert_presented":                    true,
+	"cf.tls_client_auth.cert_revoked":                      true,
+	"cf.tls_client_auth.cert_serial":                       true,
+	"cf.tls_client_auth.cert_subject_dn":                   true,
+	"cf.tls_client_auth.cert_verified":                     true,
+	"cf.verified_bot_category":                             true,
+	"cf.waf.auth_detected":                                 true,
+	"cf.waf.credential_check.password_leaked":              true,
+	"cf.waf.credential_check.username_and_password_leaked": true,
+	"cf.waf.score":                                         true,
+	"cf.waf.score.class":                                   true,
+	"cf.waf.score.rce":                                     true,
+	"cf.waf.score.sqli":                                    true,
+	"cf.waf.score.xss":                                     true,
+	"cf.worker.upstream_zone":                              true,
+	"cf.zone.name":                                         true,
+	"cf.zone.plan":                                         true,
+	"http.cookie":                                          true,
+	"http.host":                                            true,
+	"http.referer":                                         true,
+	"http.request.accepted_languages":                      true,
+	"http.request.body.form":                               true,
+	"http.request.body.form.names":                         true,
+	"http.request.body.form.values":                        true,
+	"http.request.body.mime":                               true,
+	"http.request.body.raw":                                true,
+	"http.request.body.size":                               true,
+	"http.request.body.truncated":                          true,
+	"http.request.cookies":                                 true,
+	"http.request.full_uri":                                true,
+	"http.request.headers":                                 true,
+	"http.request.headers.names":                           true,
+	"http.request.headers.truncated":                       true,
+	"http.request.headers.values":                          true,
+	"http.request.jwt.claims":                              true,
+	"http.request.jwt.claims.aud":                          true,
+	"http.request.jwt.claims.aud.names":                    true,
+	"http.request.jwt.claims.iat.sec":                      true,
+	"http.request.jwt.claims.iat.sec.names":                true,
+	"http.request.jwt.claims.iss":                          true,
+	"http.request.jwt.claims.iss.names":                    true,
+	"http.request.jwt.claims.jti":                          true,
+	"http.request.jwt.claims.jti.names":                    true,
+	"http.request.jwt.claims.nbf.sec":                      true,
+	"http.request.jwt.claims.nbf.sec.names":                true,
+	"http.request.jwt.claims.sub":                          true,
+	"http.request.jwt.claims.sub.names":                    true,
+	"http.request.method":                                  true,
+	"http.request.timestamp.msec":                          true,
+	"http.request.timestamp.sec":                           true,
+	"http.request.uri":                                     true,
+	"http.request.uri.args":                                true,
+	"http.request.uri.args.names":                          true,
+	"http.request.uri.args.values":                         true,
+	"http.request.uri.path":                                true,
+	"http.request.uri.path.extension":                      true,
+	"http.request.uri.query":                               true,
+	"http.request.version":                                 true,
+	"http.response.code":                                   true,
+	"http.response.content_type.media_type":                true,
+	"http.response.headers":                                true,
+	"http.response.headers.names":                          true,
+	"http.response.headers.values":                         true,
+	"http.user_agent":                                      true,
+	"http.x_forwarded_for":                                 true,
+	"icmp.code":                                            true,
+	"icmp.type":                                            true,
+	"ip.dst":                                               true,
+	"ip.geoip.asnum":                                       true,
+	"ip.geoip.continent":                                   true,
+	"ip.geoip.country":                                     true,
+	"ip.geoip.is_in_european_union":                        true,
+	"ip.geoip.subdivision_1_iso_code":                      true,
+	"ip.geoip.subdivision_2_iso_code":                      true,
+	"ip.hdr_len":                                           true,
+	"ip.len":                                               true,
+	"ip.proto":                                             true,
+	"ip.src":                                               true,
+	"ip.src.asnum":                                         true,
+	"ip.src.city":                                          true,
+	"ip.src.continent":                                     true,
+	"ip.src.country":                                       true,
+	"ip.src.is_in_european_union":                          true,
+	"ip.src.lat":                                           true,
+	"ip.src.lon":                                           true,
+	"ip.src.metro_code":                                    true,
+	"ip.src.postal_code":                                   true,
+	"ip.src.region":                                        true,
+	"ip.src.region_code":                                   true,
+	"ip.src.subdivision_1_iso_code":                        true,
+	"ip.src.subdivision_2_iso_code":                        true,
+	"ip.src.timezone.name":                                 true,
+	"ip.ttl":                                               true,
+	"raw.http.request.full_uri":                            true,
+	"raw.http.request.uri":                                 true,
+	"raw.http.request.uri.args":                            true,
+	"raw.http.request.uri.args.names":                      true,
+	"raw.http.request.uri.args.values":                     true,
+	"raw.http.request.uri.path":                            true,
+	"raw.http.request.uri.path.extension":                  true,
+	"raw.http.request.uri.query":                           true,
+	"tcp.dstport":                                          true,
+	"tcp.flags":                                            true,
+	"tcp.srcport":                                          true,
+	"udp.dstport":                                          true,
+	"udp.srcport":                                          true,
+}
+
 // Filter holds the structure of the filter type.
 type Filter struct {
 	ID          string `json:"id,omitempty"`
@@ -288,3 +427,126 @@ func (api *API) ValidateFilterExpression(ctx context.Context, expression string)
 
 	return nil
 }
+
+// filterExpressionOperators are the symbolic operators of the Rules
+// language.
+var filterExpressionOperators = map[string]bool{
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	"~": true, "!": true, "&&": true, "||": true, "^^": true,
+}
+
+// FilterExpressionSyntaxOptions controls the field check made by
+// ValidateFilterExpressionSyntaxWithOptions.
+type FilterExpressionSyntaxOptions struct {
+	// Fields are accepted in addition to the known fields of the Rules
+	// language, for example fields that were added to the language after
+	// this version of the library.
+	Fields []string
+
+	// SkipFieldCheck accepts any field.
+	SkipFieldCheck bool
+}
+
+// ValidateFilterExpressionSyntax performs a client side check of a filter
+// expression without calling the API. It ensures that parentheses, brackets
+// and braces are balanced, that string literals, including raw strings such
+// as `r"..."` and `r#"..."#`, are terminated, that symbolic operators are
+// valid and that all referenced fields are known fields of the Rules
+// language.
+//
+// It is not a full Wirefilter parser so an expression that passes may still
+// be rejected by `ValidateFilterExpression`.
+func ValidateFilterExpressionSyntax(expression string) error {
+	return ValidateFilterExpressionSyntaxWithOptions(expression, FilterExpressionSyntaxOptions{})
+}
+
+// ValidateFilterExpressionSyntaxWithOptions is ValidateFilterExpressionSyntax
+// with additional accepted fields, or without the field check.
+func ValidateFilterExpressionSyntaxWithOptions(expression string, opts FilterExpressionSyntaxOptions) error {
+	knownField := func(field string) bool {
+		if opts.SkipFieldCheck || filterExpressionFields[field] {
+			return true
+		}
+		for _, f := range opts.Fields {
+			if f == field {
+				return true
+			}
+		}
+		return false
+	}
+
+	if strings.TrimSpace(expression) == "" {
+		return errors.New("filter expression cannot be empty")
+	}
+
+	closers := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var stack []rune
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '"':
+			start := i
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(runes) {
+				return fmt.Errorf("unterminated string literal starting at position %d", start)
+			}
+		case c == 'r' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '#'):
+			start := i
+			hashes := 0
+			for i++; i < len(runes) && runes[i] == '#'; i++ {
+				hashes++
+			}
+			if i >= len(runes) || runes[i] != '"' {
+				return fmt.Errorf("invalid raw string literal at position %d", start)
+			}
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '"' && strings.HasPrefix(string(runes[i+1:]), strings.Repeat("#", hashes)) {
+					i += hashes
+					closed = true
+					break
+				}
+			}
+			if !closed {
+				return fmt.Errorf("unterminated string literal starting at position %d", start)
+			}
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, c)
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closers[c] {
+				return fmt.Errorf("unexpected %q at position %d", c, i)
+			}
+			stack = stack[:len(stack)-1]
+		case strings.ContainsRune("=!<>~&|^", c):
+			start := i
+			for i+1 < len(runes) && strings.ContainsRune("=!<>~&|^", runes[i+1]) {
+				i++
+			}
+			operator := string(runes[start : i+1])
+			if !filterExpressionOperators[operator] {
+				return fmt.Errorf("unknown operator %q at position %d", operator, start)
+			}
+		case unicode.IsLetter(c):
+			start := i
+			for i+1 < len(runes) && (unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1]) || runes[i+1] == '_' || runes[i+1] == '.') {
+				i++
+			}
+			identifier := string(runes[start : i+1])
+			if strings.Contains(identifier, ".") && !knownField(identifier) {
+				return fmt.Errorf("unknown field %q at position %d", identifier, start)
+			}
+		}
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q in filter expression", stack[len(stack)-1])
+	}
+
+	return nil
+}
@@ -389,3 +389,58 @@ func TestDeleteFilterWithMissingID(t *testing.T) {
 	err := client.DeleteFilter(context.Background(), ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), "")
 	assert.EqualError(t, err, "filter ID cannot be empty")
 }
+
+func TestValidateFilterExpressionSyntax(t *testing.T) {
+	valid := []string{
+		`(http.request.uri.path ~ ".*wp-login.php" or http.request.uri.path ~ ".*xmlrpc.php") and ip.src ne 1.2.3.4`,
+		`ip.geoip.country in {"GB" "FR"} and cf.threat_score gt 10`,
+		`http.request.headers["x-custom"][0] eq "a \"quoted\" (value"`,
+		`lower(http.host) eq "example.com"`,
+		`not ssl`,
+		`http.request.jwt.claims["aud"][0] eq "api" && cf.tls_client_auth.cert_verified`,
+		`cf.bot_management.score lt 30 or ip.src.asnum == 1`,
+		`http.request.uri.path matches r"^/api/v\d+/" and http.host != "example.com"`,
+		`http.request.uri.path ~ r#"^/a"b(/"#`,
+		`!(ip.src in $blocklist) ^^ http.request.method eq "POST"`,
+	}
+	for _, expression := range valid {
+		assert.NoError(t, ValidateFilterExpressionSyntax(expression), expression)
+	}
+
+	invalid := map[string]string{
+		``:                                                    "cannot be empty",
+		`(http.host eq "example.com"`:                         "unclosed",
+		`http.host eq "example.com")`:                         "unexpected ')'",
+		`ip.src in {1.2.3.4 ]`:                                "unexpected ']'",
+		`http.host eq "example.com`:                           "unterminated string literal",
+		`http.host = "example.com"`:                           `unknown operator "="`,
+		`ssl & http.host eq "a"`:                              `unknown operator "&"`,
+		`http.host matches r"^www`:                            "unterminated string literal",
+		`http.host matches r#"^www"`:                          "unterminated string literal",
+		`http.host matches r#^www`:                            "invalid raw string literal",
+		`http.hostname eq "example.com"`:                      `unknown field "http.hostname"`,
+		`ip.geoip.country eq "GB" and http.reqest.uri eq "/"`: `unknown field "http.reqest.uri"`,
+	}
+	for expression, message := range invalid {
+		err := ValidateFilterExpressionSyntax(expression)
+		if assert.Error(t, err, expression) {
+			assert.Contains(t, err.Error(), message)
+		}
+	}
+}
+
+func TestValidateFilterExpressionSyntaxWithOptions(t *testing.T) {
+	expression := `cf.some_future_field == 1 and http.host eq "example.com"`
+	assert.ErrorContains(t, ValidateFilterExpressionSyntax(expression), `unknown field "cf.some_future_field"`)
+
+	assert.NoError(t, ValidateFilterExpressionSyntaxWithOptions(expression, FilterExpressionSyntaxOptions{
+		Fields: []string{"cf.some_future_field"},
+	}))
+	assert.NoError(t, ValidateFilterExpressionSyntaxWithOptions(expression, FilterExpressionSyntaxOptions{
+		SkipFieldCheck: true,
+	}))
+
+	// The syntax is still checked without the field check.
+	err := ValidateFilterExpressionSyntaxWithOptions(`(cf.some_future_field == 1`, FilterExpressionSyntaxOptions{SkipFieldCheck: true})
+	assert.ErrorContains(t, err, "unclosed")
+}
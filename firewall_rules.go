@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -10,6 +11,23 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var (
+	ErrInvalidFirewallRuleAction   = errors.New("invalid firewall rule action")
+	ErrFirewallRuleProductsMissing = errors.New("firewall rule products must be set when the action is bypass")
+	ErrFirewallRuleProductsInvalid = errors.New("firewall rule products are only valid when the action is bypass")
+)
+
+// firewallRuleActions are the actions accepted for a firewall rule.
+var firewallRuleActions = map[string]bool{
+	"block":             true,
+	"challenge":         true,
+	"js_challenge":      true,
+	"managed_challenge": true,
+	"allow":             true,
+	"log":               true,
+	"bypass":            true,
+}
+
 // FirewallRule is the struct of the firewall rule.
 type FirewallRule struct {
 	ID          string      `json:"id,omitempty"`
@@ -139,6 +157,12 @@ func (api *API) FirewallRule(ctx context.Context, rc *ResourceContainer, firewal
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/post/
 func (api *API) CreateFirewallRules(ctx context.Context, rc *ResourceContainer, params []FirewallRuleCreateParams) ([]FirewallRule, error) {
+	for _, firewallRule := range params {
+		if err := validateFirewallRuleAction(firewallRule.Action, firewallRule.Products); err != nil {
+			return []FirewallRule{}, err
+		}
+	}
+
 	uri := fmt.Sprintf("/zones/%s/firewall/rules", rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
@@ -163,6 +187,10 @@ func (api *API) UpdateFirewallRule(ctx context.Context, rc *ResourceContainer, p
 		return FirewallRule{}, fmt.Errorf("firewall rule ID cannot be empty")
 	}
 
+	if err := validateFirewallRuleAction(params.Action, params.Products); err != nil {
+		return FirewallRule{}, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/firewall/rules/%s", rc.Identifier, params.ID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
@@ -187,6 +215,10 @@ func (api *API) UpdateFirewallRules(ctx context.Context, rc *ResourceContainer,
 		if firewallRule.ID == "" {
 			return []FirewallRule{}, fmt.Errorf("firewall ID cannot be empty")
 		}
+
+		if err := validateFirewallRuleAction(firewallRule.Action, firewallRule.Products); err != nil {
+			return []FirewallRule{}, err
+		}
 	}
 
 	uri := fmt.Sprintf("/zones/%s/firewall/rules", rc.Identifier)
@@ -242,3 +274,21 @@ func (api *API) DeleteFirewallRules(ctx context.Context, rc *ResourceContainer,
 
 	return nil
 }
+
+// validateFirewallRuleAction ensures the action is one the API accepts and
+// that products are only provided, and required, for bypass rules.
+func validateFirewallRuleAction(action string, products []string) error {
+	if !firewallRuleActions[action] {
+		return fmt.Errorf("%w: %q", ErrInvalidFirewallRuleAction, action)
+	}
+
+	if action == "bypass" && len(products) == 0 {
+		return ErrFirewallRuleProductsMissing
+	}
+
+	if action != "bypass" && len(products) > 0 {
+		return ErrFirewallRuleProductsInvalid
+	}
+
+	return nil
+}
@@ -628,3 +628,28 @@ func TestDeleteFirewallRuleWithMissingID(t *testing.T) {
 	err := client.DeleteFirewallRule(context.Background(), ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), "")
 	assert.EqualError(t, err, "firewall rule ID cannot be empty")
 }
+
+func TestCreateFirewallRulesActionValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	filter := Filter{ID: "b7ff25282d394be7b945e23c7106ce8a"}
+
+	_, err := client.CreateFirewallRules(context.Background(), ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), []FirewallRuleCreateParams{
+		{Action: "deny", Filter: filter},
+	})
+	assert.ErrorIs(t, err, ErrInvalidFirewallRuleAction)
+
+	_, err = client.CreateFirewallRules(context.Background(), ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), []FirewallRuleCreateParams{
+		{Action: "bypass", Filter: filter},
+	})
+	assert.ErrorIs(t, err, ErrFirewallRuleProductsMissing)
+
+	_, err = client.UpdateFirewallRule(context.Background(), ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), FirewallRuleUpdateParams{
+		ID:       "f2d427378e7542acb295380d352e2ebd",
+		Action:   "block",
+		Products: []string{"waf"},
+		Filter:   filter,
+	})
+	assert.ErrorIs(t, err, ErrFirewallRuleProductsInvalid)
+}
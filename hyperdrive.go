@@ -0,0 +1,236 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingHyperdriveConfigID       = errors.New("required hyperdrive config id is missing")
+	ErrMissingHyperdriveConfigName     = errors.New("required hyperdrive config name is missing")
+	ErrMissingHyperdriveConfigOrigin   = errors.New("required hyperdrive config origin is missing")
+	ErrMissingHyperdriveConfigPassword = errors.New("required hyperdrive config password is missing")
+)
+
+type HyperdriveConfig struct {
+	ID      string                  `json:"id,omitempty"`
+	Name    string                  `json:"name,omitempty"`
+	Origin  HyperdriveConfigOrigin  `json:"origin,omitempty"`
+	Caching HyperdriveConfigCaching `json:"caching,omitempty"`
+}
+
+// HyperdriveConfigOrigin is the database Hyperdrive pools connections to.
+//
+// Password is write only and is never returned by the API. It is redacted
+// when the origin is formatted to prevent it from ending up in logs.
+type HyperdriveConfigOrigin struct {
+	Database string `json:"database,omitempty"`
+	Password string `json:"password,omitempty"`
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Scheme   string `json:"scheme,omitempty"`
+	User     string `json:"user,omitempty"`
+}
+
+// String implements fmt.Stringer and omits the password.
+func (o HyperdriveConfigOrigin) String() string {
+	password := ""
+	if o.Password != "" {
+		password = "[redacted]"
+	}
+
+	return fmt.Sprintf("{Database:%s Password:%s Host:%s Port:%d Scheme:%s User:%s}", o.Database, password, o.Host, o.Port, o.Scheme, o.User)
+}
+
+// GoString implements fmt.GoStringer and omits the password.
+func (o HyperdriveConfigOrigin) GoString() string {
+	return "cloudflare.HyperdriveConfigOrigin" + o.String()
+}
+
+type HyperdriveConfigCaching struct {
+	Disabled             *bool `json:"disabled,omitempty"`
+	MaxAge               int   `json:"max_age,omitempty"`
+	StaleWhileRevalidate int   `json:"stale_while_revalidate,omitempty"`
+}
+
+type HyperdriveConfigListResponse struct {
+	Response
+	Result []HyperdriveConfig `json:"result"`
+}
+
+type HyperdriveConfigResponse struct {
+	Response
+	Result HyperdriveConfig `json:"result"`
+}
+
+type ListHyperdriveConfigParams struct{}
+
+type CreateHyperdriveConfigParams struct {
+	Name    string                  `json:"name"`
+	Origin  HyperdriveConfigOrigin  `json:"origin"`
+	Caching HyperdriveConfigCaching `json:"caching,omitempty"`
+}
+
+type UpdateHyperdriveConfigParams struct {
+	HyperdriveID string                  `json:"-"`
+	Name         string                  `json:"name"`
+	Origin       HyperdriveConfigOrigin  `json:"origin"`
+	Caching      HyperdriveConfigCaching `json:"caching,omitempty"`
+}
+
+// ListHyperdriveConfigs returns the Hyperdrive configs owned by an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/list-hyperdrive
+func (api *API) ListHyperdriveConfigs(ctx context.Context, rc *ResourceContainer, params ListHyperdriveConfigParams) ([]HyperdriveConfig, error) {
+	if rc.Level != AccountRouteLevel {
+		return []HyperdriveConfig{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []HyperdriveConfig{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []HyperdriveConfig{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r HyperdriveConfigListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateHyperdriveConfig creates a new Hyperdrive config.
+//
+// API reference: https://developers.cloudflare.com/api/operations/create-hyperdrive
+func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params CreateHyperdriveConfigParams) (HyperdriveConfig, error) {
+	if rc.Level != AccountRouteLevel {
+		return HyperdriveConfig{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return HyperdriveConfig{}, ErrMissingAccountID
+	}
+
+	if params.Name == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigName
+	}
+
+	if params.Origin.Host == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOrigin
+	}
+
+	if params.Origin.Password == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigPassword
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r HyperdriveConfigResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetHyperdriveConfig returns a single Hyperdrive config.
+//
+// API reference: https://developers.cloudflare.com/api/operations/get-hyperdrive
+func (api *API) GetHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string) (HyperdriveConfig, error) {
+	if rc.Level != AccountRouteLevel {
+		return HyperdriveConfig{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return HyperdriveConfig{}, ErrMissingAccountID
+	}
+
+	if hyperdriveID == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, hyperdriveID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r HyperdriveConfigResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateHyperdriveConfig updates an existing Hyperdrive config.
+//
+// API reference: https://developers.cloudflare.com/api/operations/update-hyperdrive
+func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params UpdateHyperdriveConfigParams) (HyperdriveConfig, error) {
+	if rc.Level != AccountRouteLevel {
+		return HyperdriveConfig{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return HyperdriveConfig{}, ErrMissingAccountID
+	}
+
+	if params.HyperdriveID == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, params.HyperdriveID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r HyperdriveConfigResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteHyperdriveConfig deletes a Hyperdrive config.
+//
+// API reference: https://developers.cloudflare.com/api/operations/delete-hyperdrive
+func (api *API) DeleteHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if hyperdriveID == "" {
+		return ErrMissingHyperdriveConfigID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, hyperdriveID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHyperdriveConfigID = "6b7efc370ea34ded8327fa20698dfe3a"
+
+const testHyperdriveConfigJSON = `{
+  "id": "6b7efc370ea34ded8327fa20698dfe3a",
+  "name": "example-hyperdrive",
+  "origin": {
+    "database": "postgres",
+    "host": "database.example.com",
+    "port": 5432,
+    "scheme": "postgres",
+    "user": "postgres"
+  },
+  "caching": {
+    "disabled": false,
+    "max_age": 30,
+    "stale_while_revalidate": 15
+  }
+}`
+
+func testHyperdriveConfig() HyperdriveConfig {
+	return HyperdriveConfig{
+		ID:   testHyperdriveConfigID,
+		Name: "example-hyperdrive",
+		Origin: HyperdriveConfigOrigin{
+			Database: "postgres",
+			Host:     "database.example.com",
+			Port:     5432,
+			Scheme:   "postgres",
+			User:     "postgres",
+		},
+		Caching: HyperdriveConfigCaching{
+			Disabled:             BoolPtr(false),
+			MaxAge:               30,
+			StaleWhileRevalidate: 15,
+		},
+	}
+}
+
+func TestListHyperdriveConfigs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/hyperdrive/configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [%s]
+}`, testHyperdriveConfigJSON)
+	})
+
+	_, err := client.ListHyperdriveConfigs(context.Background(), AccountIdentifier(""), ListHyperdriveConfigParams{})
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+
+	actual, err := client.ListHyperdriveConfigs(context.Background(), AccountIdentifier(testAccountID), ListHyperdriveConfigParams{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []HyperdriveConfig{testHyperdriveConfig()}, actual)
+	}
+}
+
+func TestGetHyperdriveConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/hyperdrive/configs/"+testHyperdriveConfigID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": %s
+}`, testHyperdriveConfigJSON)
+	})
+
+	_, err := client.GetHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingHyperdriveConfigID)
+
+	actual, err := client.GetHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), testHyperdriveConfigID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testHyperdriveConfig(), actual)
+	}
+}
+
+func TestCreateHyperdriveConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/hyperdrive/configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"name": "example-hyperdrive",
+			"origin": {
+				"database": "postgres",
+				"password": "password",
+				"host": "database.example.com",
+				"port": 5432,
+				"scheme": "postgres",
+				"user": "postgres"
+			},
+			"caching": {"max_age": 30, "stale_while_revalidate": 15}
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": %s
+}`, testHyperdriveConfigJSON)
+	})
+
+	params := CreateHyperdriveConfigParams{
+		Name: "example-hyperdrive",
+		Origin: HyperdriveConfigOrigin{
+			Database: "postgres",
+			Password: "password",
+			Host:     "database.example.com",
+			Port:     5432,
+			Scheme:   "postgres",
+			User:     "postgres",
+		},
+		Caching: HyperdriveConfigCaching{
+			MaxAge:               30,
+			StaleWhileRevalidate: 15,
+		},
+	}
+
+	_, err := client.CreateHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), CreateHyperdriveConfigParams{Origin: params.Origin})
+	assert.ErrorIs(t, err, ErrMissingHyperdriveConfigName)
+
+	_, err = client.CreateHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), CreateHyperdriveConfigParams{Name: params.Name})
+	assert.ErrorIs(t, err, ErrMissingHyperdriveConfigOrigin)
+
+	actual, err := client.CreateHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), params)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testHyperdriveConfig(), actual)
+	}
+}
+
+func TestUpdateHyperdriveConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/hyperdrive/configs/"+testHyperdriveConfigID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": %s
+}`, testHyperdriveConfigJSON)
+	})
+
+	_, err := client.UpdateHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), UpdateHyperdriveConfigParams{})
+	assert.ErrorIs(t, err, ErrMissingHyperdriveConfigID)
+
+	actual, err := client.UpdateHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), UpdateHyperdriveConfigParams{
+		HyperdriveID: testHyperdriveConfigID,
+		Name:         "example-hyperdrive",
+		Origin: HyperdriveConfigOrigin{
+			Database: "postgres",
+			Password: "password",
+			Host:     "database.example.com",
+			Port:     5432,
+			Scheme:   "postgres",
+			User:     "postgres",
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testHyperdriveConfig(), actual)
+	}
+}
+
+func TestDeleteHyperdriveConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/hyperdrive/configs/"+testHyperdriveConfigID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": null
+}`)
+	})
+
+	err := client.DeleteHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), testHyperdriveConfigID)
+	assert.NoError(t, err)
+}
+
+func TestHyperdriveConfigOrigin_RedactsPassword(t *testing.T) {
+	origin := HyperdriveConfigOrigin{
+		Database: "postgres",
+		Password: "hunter2",
+		Host:     "database.example.com",
+		Port:     5432,
+		Scheme:   "postgres",
+		User:     "postgres",
+	}
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v"} {
+		assert.NotContains(t, fmt.Sprintf(format, origin), "hunter2")
+		assert.NotContains(t, fmt.Sprintf(format, HyperdriveConfig{Origin: origin}), "hunter2")
+	}
+}
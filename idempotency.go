@@ -0,0 +1,110 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// IdempotencyKeyHeader is the header used to pass the idempotency key of a
+// request to the API.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that attaches an idempotency key derived
+// from key to mutating requests (POST, PUT, PATCH and DELETE) made with it.
+// Methods such as UpsertDNSRecord or CreateZones make several writes with the
+// same context, so the key sent is scoped to each request: it is key followed
+// by a hash of the method, URI and body (`<key>:<hash>`). The same key is sent
+// on every retry of a request, and when the whole call is repeated with the
+// same key.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// WithAutoIdempotency generates a new idempotency key for every mutating API
+// call that doesn't already have one, reusing it across retries of that call
+// so that retried creates don't result in duplicate resources.
+func WithAutoIdempotency() Option {
+	return func(api *API) error {
+		api.autoIdempotency = true
+		return nil
+	}
+}
+
+// idempotencyHeaders returns the headers for a request with the idempotency
+// key added when one should be sent.
+func (api *API) idempotencyHeaders(ctx context.Context, method, uri string, params interface{}, headers http.Header) (http.Header, error) {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return headers, nil
+	}
+
+	if headers.Get(IdempotencyKeyHeader) != "" || api.headers.Get(IdempotencyKeyHeader) != "" {
+		return headers, nil
+	}
+
+	var err error
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	switch {
+	case key != "":
+		key, err = requestIdempotencyKey(key, method, uri, params)
+	case api.autoIdempotency:
+		key, err = newIdempotencyKey()
+	default:
+		return headers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	h := make(http.Header)
+	copyHeader(h, headers)
+	h.Set(IdempotencyKeyHeader, key)
+
+	return h, nil
+}
+
+// requestIdempotencyKey scopes the idempotency key of a context to a single
+// request. Streamed bodies can't be hashed without consuming them, so only the
+// method and URI are used for those.
+func requestIdempotencyKey(key, method, uri string, params interface{}) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", method, uri)
+
+	if _, ok := params.(io.Reader); !ok && params != nil {
+		body, ok := params.([]byte)
+		if !ok {
+			var err error
+			body, err = json.Marshal(params)
+			if err != nil {
+				return "", fmt.Errorf("error marshalling params to JSON: %w", err)
+			}
+		}
+		h.Write(body)
+	}
+
+	return key + ":" + hex.EncodeToString(h.Sum(nil))[:16], nil
+}
+
+// newIdempotencyKey returns a random version 4 UUID.
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:], nil
+}
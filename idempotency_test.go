@@ -0,0 +1,111 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoIdempotency_ReusesKeyAcrossRetries(t *testing.T) {
+	var keys []string
+	httpClient := &http.Client{
+		Transport: RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+			if len(keys) == 1 {
+				return nil, errors.New("connection reset by peer")
+			}
+
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(http.StatusOK)
+			return rec.Result(), nil
+		}),
+	}
+
+	api, err := New("deadbeef", "cloudflare@example.org", HTTPClient(httpClient), UsingRetryPolicy(1, 0, 0), UsingRateLimit(100000), WithAutoIdempotency())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.makeRequestContext(context.Background(), http.MethodPost, "/zones/"+testZoneID+"/dns_records", nil)
+	assert.NoError(t, err)
+
+	if assert.Len(t, keys, 2) {
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+	}
+
+	// A new logical call gets a new key.
+	_, err = api.makeRequestContext(context.Background(), http.MethodPost, "/zones/"+testZoneID+"/dns_records", nil)
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 3) {
+		assert.NotEqual(t, keys[0], keys[2])
+	}
+
+	// Reads are never given a key.
+	_, err = api.makeRequestContext(context.Background(), http.MethodGet, "/zones/"+testZoneID+"/dns_records", nil)
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 4) {
+		assert.Empty(t, keys[3])
+	}
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var keys []string
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		} else {
+			assert.Empty(t, r.Header.Get(IdempotencyKeyHeader))
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := WithIdempotencyKey(context.Background(), "my-key")
+	for _, name := range []string{"www", "api", "www"} {
+		_, err := client.makeRequestContext(ctx, http.MethodPost, "/zones/"+testZoneID+"/dns_records", DNSRecord{Type: "A", Name: name, Content: "192.0.2.1"})
+		assert.NoError(t, err)
+	}
+
+	_, err := client.makeRequestContext(context.Background(), http.MethodDelete, "/zones/"+testZoneID+"/dns_records", nil)
+	assert.NoError(t, err)
+
+	// Each write made with the context gets its own key, and repeating a
+	// write repeats its key.
+	if assert.Len(t, keys, 3) {
+		assert.Regexp(t, `^my-key:[0-9a-f]{16}$`, keys[0])
+		assert.NotEqual(t, keys[0], keys[1])
+		assert.Equal(t, keys[0], keys[2])
+	}
+}
+
+func TestIdempotencyKeyHeaderMakesRequestsRetryable(t *testing.T) {
+	calls := 0
+	httpClient := &http.Client{
+		Transport: RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			rec := httptest.NewRecorder()
+			if calls == 1 {
+				rec.WriteHeader(http.StatusBadGateway)
+			} else {
+				rec.WriteHeader(http.StatusOK)
+			}
+			return rec.Result(), nil
+		}),
+	}
+
+	api, err := New("deadbeef", "cloudflare@example.org", HTTPClient(httpClient), UsingRetryPolicy(1, 0, 0), UsingRateLimit(100000), Headers(http.Header{IdempotencyKeyHeader: []string{"client-key"}}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.makeRequestContext(context.Background(), http.MethodPost, "/zones/"+testZoneID+"/dns_records", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,87 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListImagesSigningKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/keys", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "keys": [
+      {"name": "default", "value": "Oix0bbNaT8Rge9PuyxUBrjI6zrgnsyJ5="}
+    ]
+  }
+}`)
+	})
+
+	want := []ImagesSigningKey{{Name: "default", Value: "Oix0bbNaT8Rge9PuyxUBrjI6zrgnsyJ5="}}
+
+	actual, err := client.ListImagesSigningKeys(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestRotateImagesSigningKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/keys/2024-q2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "keys": [
+      {"name": "default", "value": "Oix0bbNaT8Rge9PuyxUBrjI6zrgnsyJ5="},
+      {"name": "2024-q2", "value": "bc8ee3f4b1a4e5d7c7a2c1f0e9d8b7a6="}
+    ]
+  }
+}`)
+	})
+
+	want := []ImagesSigningKey{
+		{Name: "default", Value: "Oix0bbNaT8Rge9PuyxUBrjI6zrgnsyJ5="},
+		{Name: "2024-q2", Value: "bc8ee3f4b1a4e5d7c7a2c1f0e9d8b7a6="},
+	}
+
+	_, err := client.RotateImagesSigningKey(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingImagesSigningKeyName)
+
+	actual, err := client.RotateImagesSigningKey(context.Background(), AccountIdentifier(testAccountID), "2024-q2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestGenerateSignedImageURL(t *testing.T) {
+	expiry := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	actual, err := GenerateSignedImageURL("https://imagedelivery.net/ZWd9g1K7eljCn_KDTu_MWA/083eb7b2-5392-4565-b69e-aff66acddd00/public?width=300", "my-signing-key", expiry)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://imagedelivery.net/ZWd9g1K7eljCn_KDTu_MWA/083eb7b2-5392-4565-b69e-aff66acddd00/public?exp=1735689600&width=300&sig=e3577824fd6c9a540b7e5617585bd0dcd8152404b1592f1b71f91889bdbf4d0d", actual)
+	}
+
+	_, err = GenerateSignedImageURL("https://imagedelivery.net/ZWd9g1K7eljCn_KDTu_MWA/083eb7b2-5392-4565-b69e-aff66acddd00/public", "", expiry)
+	assert.ErrorIs(t, err, ErrMissingImagesSigningKeyValue)
+
+	_, err = GenerateSignedImageURL("/083eb7b2-5392-4565-b69e-aff66acddd00/public", "my-signing-key", expiry)
+	assert.ErrorIs(t, err, ErrInvalidImagesDeliveryURL)
+}
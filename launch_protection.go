@@ -0,0 +1,173 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// launchProtectionRollbackTimeout bounds deleting the waiting room again when
+// setting up launch protection fails.
+const launchProtectionRollbackTimeout = 30 * time.Second
+
+var (
+	ErrMissingLaunchProtectionExpression = errors.New("launch protection requires an expression matching the API requests to rate limit")
+	ErrMissingLaunchProtectionRateLimit  = errors.New("launch protection requires a rate limit with requests per period and period")
+)
+
+// LaunchProtectionParams configures the waiting room and rate limit rule
+// created by SetupLaunchProtection.
+type LaunchProtectionParams struct {
+	// WaitingRoom is created as is, so it needs at least a Name, Host,
+	// TotalActiveUsers, NewUsersPerMinute and SessionDuration.
+	WaitingRoom WaitingRoom
+
+	// Expression matches the API requests that bypass the waiting room and
+	// are rate limited instead, for example
+	// `starts_with(http.request.uri.path, "/api/")`.
+	Expression string
+
+	// RateLimit needs at least RequestsPerPeriod and Period. Characteristics
+	// default to `cf.colo.id` and `ip.src`.
+	RateLimit RulesetRuleRateLimit
+
+	// Action defaults to `block`.
+	Action RulesetRuleAction
+
+	Description string
+}
+
+// LaunchProtection identifies the resources created by
+// SetupLaunchProtection.
+type LaunchProtection struct {
+	WaitingRoomID   string
+	RulesetID       string
+	RateLimitRuleID string
+}
+
+// SetupLaunchProtection creates a waiting room for a launch together with a
+// rate limit rule for API requests that should not be queued. Requests
+// matching the expression bypass the waiting room and are counted by a new
+// rule in the `http_ratelimit` phase instead. When adding the rate limit rule
+// fails the waiting room is deleted again, even if ctx was cancelled.
+func (api *API) SetupLaunchProtection(ctx context.Context, rc *ResourceContainer, params LaunchProtectionParams) (LaunchProtection, error) {
+	if rc.Level != ZoneRouteLevel {
+		return LaunchProtection{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return LaunchProtection{}, ErrMissingZoneID
+	}
+
+	if params.Expression == "" {
+		return LaunchProtection{}, ErrMissingLaunchProtectionExpression
+	}
+
+	if params.RateLimit.RequestsPerPeriod == 0 || params.RateLimit.Period == 0 {
+		return LaunchProtection{}, ErrMissingLaunchProtectionRateLimit
+	}
+
+	if len(params.RateLimit.Characteristics) == 0 {
+		params.RateLimit.Characteristics = []string{"cf.colo.id", "ip.src"}
+	}
+
+	if params.Action == "" {
+		params.Action = RulesetRuleActionBlock
+	}
+
+	waitingRoom, err := api.CreateWaitingRoom(ctx, rc.Identifier, params.WaitingRoom)
+	if err != nil {
+		return LaunchProtection{}, err
+	}
+
+	protection := LaunchProtection{WaitingRoomID: waitingRoom.ID}
+
+	protection.RulesetID, protection.RateLimitRuleID, err = api.addLaunchProtectionRules(ctx, rc, waitingRoom.ID, params)
+	if err != nil {
+		// The setup may have failed because ctx was cancelled or hit its
+		// deadline, so the rollback gets a context of its own.
+		rollbackCtx, cancel := context.WithTimeout(withoutCancel(ctx), launchProtectionRollbackTimeout)
+		defer cancel()
+
+		if rollbackErr := api.DeleteWaitingRoom(rollbackCtx, rc.Identifier, waitingRoom.ID); rollbackErr != nil {
+			return LaunchProtection{}, fmt.Errorf("%w (deleting waiting room %s failed: %s)", err, waitingRoom.ID, rollbackErr)
+		}
+
+		return LaunchProtection{}, err
+	}
+
+	return protection, nil
+}
+
+// withoutCancel returns a context that keeps the values of ctx, such as its
+// trace span, but is never cancelled and has no deadline.
+func withoutCancel(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// addLaunchProtectionRules lets the API requests bypass the waiting room and
+// appends the rate limit rule for them to the zone's `http_ratelimit`
+// entrypoint, returning the IDs of the ruleset and the new rule.
+func (api *API) addLaunchProtectionRules(ctx context.Context, rc *ResourceContainer, waitingRoomID string, params LaunchProtectionParams) (string, string, error) {
+	_, err := api.CreateWaitingRoomRule(ctx, rc, CreateWaitingRoomRuleParams{
+		WaitingRoomID: waitingRoomID,
+		Rule: WaitingRoomRule{
+			Action:      "bypass_waiting_room",
+			Expression:  params.Expression,
+			Description: params.Description,
+			Enabled:     BoolPtr(true),
+		},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	rateLimit := params.RateLimit
+	ref := "launch_protection_" + waitingRoomID
+	rule := RulesetRule{
+		Action:      params.Action,
+		Expression:  params.Expression,
+		Description: params.Description,
+		Ref:         ref,
+		Enabled:     BoolPtr(true),
+		RateLimit:   &rateLimit,
+	}
+
+	var rules []RulesetRule
+	entrypoint, err := api.GetEntrypointRuleset(ctx, rc, string(RulesetPhaseHTTPRatelimit))
+	if err != nil {
+		var notFoundErr *NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return "", "", err
+		}
+	} else {
+		rules = entrypoint.Rules
+	}
+
+	ruleset, err := api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase:       string(RulesetPhaseHTTPRatelimit),
+		Description: entrypoint.Description,
+		Rules:       append(rules, rule),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, r := range ruleset.Rules {
+		if r.Ref == ref {
+			return ruleset.ID, r.ID, nil
+		}
+	}
+
+	return ruleset.ID, "", nil
+}
@@ -0,0 +1,211 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testLaunchProtectionWaitingRoomID = "699d98642c564d2e855e9661899b7252"
+
+func launchProtectionParams() LaunchProtectionParams {
+	return LaunchProtectionParams{
+		WaitingRoom: WaitingRoom{
+			Name:              "launch",
+			Host:              "shop.example.com",
+			Path:              "/",
+			TotalActiveUsers:  1000,
+			NewUsersPerMinute: 200,
+			SessionDuration:   5,
+		},
+		Expression: `starts_with(http.request.uri.path, "/api/")`,
+		RateLimit: RulesetRuleRateLimit{
+			RequestsPerPeriod: 100,
+			Period:            60,
+			MitigationTimeout: 600,
+		},
+		Description: "launch API",
+	}
+}
+
+func handleLaunchProtectionWaitingRoom(t *testing.T, deleted *bool) {
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "launch"}}`, testLaunchProtectionWaitingRoomID)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms/"+testLaunchProtectionWaitingRoomID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		*deleted = true
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testLaunchProtectionWaitingRoomID)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms/"+testLaunchProtectionWaitingRoomID+"/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"action": "bypass_waiting_room",
+			"expression": "starts_with(http.request.uri.path, \"/api/\")",
+			"description": "launch API",
+			"enabled": true
+		}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+}
+
+func TestSetupLaunchProtection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := false
+	handleLaunchProtectionWaitingRoom(t, &deleted)
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_ratelimit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset"}], "messages": [], "result": null}`)
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, fmt.Sprintf(`{
+			"rules": [
+				{
+					"action": "block",
+					"expression": "starts_with(http.request.uri.path, \"/api/\")",
+					"description": "launch API",
+					"ref": "launch_protection_%s",
+					"enabled": true,
+					"ratelimit": {
+						"characteristics": ["cf.colo.id", "ip.src"],
+						"requests_per_period": 100,
+						"period": 60,
+						"mitigation_timeout": 600
+					}
+				}
+			]
+		}`, testLaunchProtectionWaitingRoomID), string(body))
+
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"phase": "http_ratelimit",
+				"rules": [
+					{
+						"id": "3a03d665bac047339bb530ecb439a90d",
+						"action": "block",
+						"expression": "starts_with(http.request.uri.path, \"/api/\")",
+						"ref": "launch_protection_%s"
+					}
+				]
+			}
+		}`, testLaunchProtectionWaitingRoomID)
+	})
+
+	want := LaunchProtection{
+		WaitingRoomID:   testLaunchProtectionWaitingRoomID,
+		RulesetID:       "2c0fc9fa937b11eaa1b71c4d701ab86e",
+		RateLimitRuleID: "3a03d665bac047339bb530ecb439a90d",
+	}
+
+	actual, err := client.SetupLaunchProtection(context.Background(), ZoneIdentifier(testZoneID), launchProtectionParams())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+	assert.False(t, deleted)
+}
+
+func TestSetupLaunchProtection_RollsBackWaitingRoom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := false
+	handleLaunchProtectionWaitingRoom(t, &deleted)
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_ratelimit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 20120, "message": "rate limit period not allowed"}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.SetupLaunchProtection(context.Background(), ZoneIdentifier(testZoneID), launchProtectionParams())
+	assert.Error(t, err)
+	assert.True(t, deleted)
+}
+
+func TestSetupLaunchProtection_RollsBackWaitingRoomAfterCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := false
+	handleLaunchProtectionWaitingRoom(t, &deleted)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_ratelimit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	})
+
+	_, err := client.SetupLaunchProtection(ctx, ZoneIdentifier(testZoneID), launchProtectionParams())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, deleted)
+}
+
+func TestSetupLaunchProtection_RollbackError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "launch"}}`, testLaunchProtectionWaitingRoomID)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms/"+testLaunchProtectionWaitingRoomID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1003, "message": "waiting room not found"}], "messages": [], "result": null}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms/"+testLaunchProtectionWaitingRoomID+"/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1004, "message": "invalid expression"}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.SetupLaunchProtection(context.Background(), ZoneIdentifier(testZoneID), launchProtectionParams())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid expression")
+		assert.Contains(t, err.Error(), "deleting waiting room "+testLaunchProtectionWaitingRoomID+" failed")
+		assert.Contains(t, err.Error(), "waiting room not found")
+	}
+}
+
+func TestSetupLaunchProtection_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := launchProtectionParams()
+	params.Expression = ""
+	_, err := client.SetupLaunchProtection(context.Background(), ZoneIdentifier(testZoneID), params)
+	assert.ErrorIs(t, err, ErrMissingLaunchProtectionExpression)
+
+	params = launchProtectionParams()
+	params.RateLimit.Period = 0
+	_, err = client.SetupLaunchProtection(context.Background(), ZoneIdentifier(testZoneID), params)
+	assert.ErrorIs(t, err, ErrMissingLaunchProtectionRateLimit)
+
+	_, err = client.SetupLaunchProtection(context.Background(), AccountIdentifier(testAccountID), launchProtectionParams())
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+}
@@ -354,7 +354,7 @@ func (api *API) ListListItems(ctx context.Context, rc *ResourceContainer, params
 		}
 
 		list = append(list, result.Result...)
-		if cursor := result.ResultInfo.Cursors.After; cursor == "" {
+		if cursor := result.ResultInfo.NextCursor(); cursor == "" {
 			break
 		} else {
 			params.Cursor = cursor
@@ -4,9 +4,32 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
 )
 
+const redacted = "[redacted]"
+
+// sensitiveParameters are the query parameters and JSON fields whose values
+// are masked before being logged.
+var sensitiveParameters = []string{
+	"api_key",
+	"api_token",
+	"client_secret",
+	"password",
+	"secret",
+	"token",
+}
+
+var sensitiveJSONFieldRegex = regexp.MustCompile(`("(?:` + strings.Join(sensitiveParameters, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// kvValuePathRegex matches the endpoints used to read and write Workers KV
+// values, whose payloads must never be logged.
+var kvValuePathRegex = regexp.MustCompile(`/storage/kv/namespaces/[^/]+/(?:values/|bulk)`)
+
 // silentRetryLogger is the logger provided with retryable client to stop it
 // displaying the retry attempts.
 var silentRetryLogger = log.New(io.Discard, "", log.LstdFlags)
@@ -128,3 +151,66 @@ type LeveledLoggerInterface interface {
 	// Warnf logs a warning message using Printf conventions.
 	Warnf(format string, v ...interface{})
 }
+
+// redactURL returns the URL as a string with the values of sensitive query
+// parameters masked.
+func redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+
+	query := u.Query()
+	for key := range query {
+		for _, sensitive := range sensitiveParameters {
+			if strings.EqualFold(key, sensitive) {
+				query.Set(key, redacted)
+			}
+		}
+	}
+
+	redactedURL := *u
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+// redactAuthHeaders describes the authentication headers of a request
+// without exposing their values.
+func redactAuthHeaders(headers http.Header) string {
+	var auth []string
+	if v := headers.Get("Authorization"); v != "" {
+		scheme, _, _ := strings.Cut(v, " ")
+		auth = append(auth, "Authorization: "+scheme+" "+redacted)
+	}
+
+	for _, h := range []string{"X-Auth-Email", "X-Auth-Key", "X-Auth-User-Service-Key"} {
+		if headers.Get(h) != "" {
+			auth = append(auth, h+": "+redacted)
+		}
+	}
+
+	if len(auth) == 0 {
+		return "none"
+	}
+
+	return strings.Join(auth, ", ")
+}
+
+// redactDump masks the client credentials and sensitive fields in a HTTP
+// request or response dump. The payloads of Workers KV values are dropped
+// entirely.
+func (api *API) redactDump(path string, dump []byte) []byte {
+	for _, key := range []string{api.APIKey, api.APIEmail, api.APIToken, api.APIUserServiceKey} {
+		if key != "" {
+			dump = regexp.MustCompile(regexp.QuoteMeta(key)).ReplaceAll(dump, []byte(redacted))
+		}
+	}
+
+	if kvValuePathRegex.MatchString(path) {
+		if i := strings.Index(string(dump), "\r\n\r\n"); i >= 0 && i+4 < len(dump) {
+			dump = append(dump[:i+4:i+4], []byte(redacted)...)
+		}
+		return dump
+	}
+
+	return sensitiveJSONFieldRegex.ReplaceAll(dump, []byte(`$1"`+redacted+`"`))
+}
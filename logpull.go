@@ -2,12 +2,39 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/goccy/go-json"
 )
 
+var ErrMissingLogpullTimeRange = errors.New("logpull requires a start and end time")
+
+// LogpullOptions selects the logs returned by LogpullReceived.
+type LogpullOptions struct {
+	// Start is inclusive and End is exclusive. Logs are available between
+	// one minute and seven days ago.
+	Start time.Time `url:"start"`
+	End   time.Time `url:"end"`
+
+	// Fields defaults to a small set of fields, use LogpullFields to list
+	// the available fields.
+	Fields []string `url:"fields,omitempty" del:","`
+
+	// Sample is the fraction of logs returned, between 0.001 and 1.
+	Sample float64 `url:"sample,omitempty"`
+
+	// Count limits the number of logs returned.
+	Count int `url:"count,omitempty"`
+
+	// Timestamps is the format of the timestamp fields: `unix`, `unixnano`
+	// (the default) or `rfc3339`.
+	Timestamps string `url:"timestamps,omitempty"`
+}
+
 // LogpullRetentionConfiguration describes a the structure of a Logpull Retention
 // payload.
 type LogpullRetentionConfiguration struct {
@@ -56,3 +83,58 @@ func (api *API) SetLogpullRetentionFlag(ctx context.Context, zoneID string, enab
 	}
 	return &r.Result, nil
 }
+
+// LogpullReceived returns the request logs of a zone received during the
+// time range as newline delimited JSON. When the client is configured with
+// `WithResponseStreaming` the logs are streamed from the API instead of being
+// held in memory, which is preferable as responses can be very large. The
+// caller must close the returned reader.
+//
+// API reference: https://developers.cloudflare.com/logs/logpull/requesting-logs/
+func (api *API) LogpullReceived(ctx context.Context, rc *ResourceContainer, opts LogpullOptions) (io.ReadCloser, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	if opts.Start.IsZero() || opts.End.IsZero() {
+		return nil, ErrMissingLogpullTimeRange
+	}
+
+	opts.Start = opts.Start.UTC()
+	opts.End = opts.End.UTC()
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/logs/received", rc.Identifier), opts)
+	return api.makeRequestStream(ctx, http.MethodGet, uri, nil)
+}
+
+// LogpullFields returns the fields available in the request logs of a zone,
+// keyed by name with their description.
+//
+// API reference: https://developers.cloudflare.com/logs/logpull/understanding-the-basics/#fields
+func (api *API) LogpullFields(ctx context.Context, rc *ResourceContainer) (map[string]string, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/logs/received/fields", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	err = json.Unmarshal(res, &fields)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return fields, nil
+}
@@ -3,8 +3,10 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -60,3 +62,63 @@ func TestSetLogpullRetentionFlag(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestLogpullReceived(t *testing.T) {
+	for _, streaming := range []bool{false, true} {
+		setup(WithResponseStreaming(streaming))
+
+		mux.HandleFunc("/zones/"+testZoneID+"/logs/received", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+			assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("start"))
+			assert.Equal(t, "2024-01-01T00:05:00Z", r.URL.Query().Get("end"))
+			assert.Equal(t, "ClientIP,RayID", r.URL.Query().Get("fields"))
+			assert.Equal(t, "0.1", r.URL.Query().Get("sample"))
+			fmt.Fprint(w, "{\"ClientIP\":\"192.0.2.1\",\"RayID\":\"7c5dc1a8b6b1f0a1\"}\n")
+		})
+
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		opts := LogpullOptions{
+			Start:  start,
+			End:    start.Add(5 * time.Minute),
+			Fields: []string{"ClientIP", "RayID"},
+			Sample: 0.1,
+		}
+
+		_, err := client.LogpullReceived(context.Background(), ZoneIdentifier(testZoneID), LogpullOptions{})
+		assert.ErrorIs(t, err, ErrMissingLogpullTimeRange)
+
+		r, err := client.LogpullReceived(context.Background(), ZoneIdentifier(testZoneID), opts)
+		if assert.NoError(t, err) {
+			logs, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, "{\"ClientIP\":\"192.0.2.1\",\"RayID\":\"7c5dc1a8b6b1f0a1\"}\n", string(logs))
+		}
+
+		teardown()
+	}
+}
+
+func TestLogpullFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received/fields", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"ClientIP": "IP address of the client",
+			"RayID": "ID of the request"
+		}`)
+	})
+
+	want := map[string]string{
+		"ClientIP": "IP address of the client",
+		"RayID":    "ID of the request",
+	}
+
+	actual, err := client.LogpullFields(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
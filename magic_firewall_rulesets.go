@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/goccy/go-json"
@@ -24,6 +26,25 @@ const (
 	MagicFirewallRulesetRuleActionBlock MagicFirewallRulesetRuleAction = "block"
 )
 
+// MagicFirewallRuleAction is the action taken for traffic matching a
+// MagicFirewallRule.
+type MagicFirewallRuleAction string
+
+const (
+	// MagicFirewallRuleActionAllow allows the traffic without evaluating the
+	// remaining rules.
+	MagicFirewallRuleActionAllow MagicFirewallRuleAction = "allow"
+
+	// MagicFirewallRuleActionBlock drops the traffic.
+	MagicFirewallRuleActionBlock MagicFirewallRuleAction = "block"
+
+	// MagicFirewallRuleActionLog logs the traffic and continues with the
+	// next rule.
+	MagicFirewallRuleActionLog MagicFirewallRuleAction = "log"
+)
+
+var ErrUnsupportedMagicFirewallRuleAction = errors.New("unsupported Magic Firewall rule action")
+
 // MagicFirewallRulesetRuleAction specifies the action for a Firewall rule.
 type MagicFirewallRulesetRuleAction string
 
@@ -204,3 +225,150 @@ func (api *API) UpdateMagicFirewallRuleset(ctx context.Context, accountID, ID st
 
 	return result.Result, nil
 }
+
+// MagicFirewallRule is a rule of the account's Magic Firewall, which filters
+// the L3/L4 traffic to Magic Transit and Magic WAN networks.
+type MagicFirewallRule struct {
+	ID  string
+	Ref string
+
+	// Expression matches network-layer fields, for example
+	// `ip.src in {192.0.2.0/24} and tcp.dstport in {22}`.
+	Expression string
+	Action     MagicFirewallRuleAction
+
+	// Priority orders the rules, lowest first, and rules with the same
+	// priority keep their order. The API evaluates rules in the order they
+	// are given, so rules read back have their 1-based position as priority.
+	Priority int
+
+	Description string
+	Enabled     *bool
+}
+
+// GetMagicFirewallRules returns the rules of the account's Magic Firewall,
+// the `magic_transit` phase entrypoint ruleset, in evaluation order.
+//
+// API reference: https://developers.cloudflare.com/api/operations/getAccountEntrypointRuleset
+func (api *API) GetMagicFirewallRules(ctx context.Context, rc *ResourceContainer) ([]MagicFirewallRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return []MagicFirewallRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []MagicFirewallRule{}, ErrMissingAccountID
+	}
+
+	ruleset, err := api.GetEntrypointRuleset(ctx, rc, string(RulesetPhaseMagicTransit))
+	if err != nil {
+		return []MagicFirewallRule{}, err
+	}
+
+	return magicFirewallRulesFromRuleset(ruleset)
+}
+
+// UpdateMagicFirewallRules replaces the rules of the account's Magic
+// Firewall, ordered by their priority, and returns the resulting rules.
+//
+// API reference: https://developers.cloudflare.com/api/operations/updateAccountEntrypointRuleset
+func (api *API) UpdateMagicFirewallRules(ctx context.Context, rc *ResourceContainer, rules []MagicFirewallRule) ([]MagicFirewallRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return []MagicFirewallRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []MagicFirewallRule{}, ErrMissingAccountID
+	}
+
+	ordered := make([]MagicFirewallRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority < ordered[j].Priority
+	})
+
+	params := UpdateEntrypointRulesetParams{
+		Phase: string(RulesetPhaseMagicTransit),
+		Rules: make([]RulesetRule, 0, len(ordered)),
+	}
+	for _, rule := range ordered {
+		r := RulesetRule{
+			ID:          rule.ID,
+			Ref:         rule.Ref,
+			Expression:  rule.Expression,
+			Description: rule.Description,
+			Enabled:     rule.Enabled,
+		}
+
+		switch rule.Action {
+		case MagicFirewallRuleActionAllow:
+			// Allowed traffic skips the remaining rules of the ruleset.
+			r.Action = RulesetRuleActionSkip
+			r.ActionParameters = &RulesetRuleActionParameters{Ruleset: "current"}
+		case MagicFirewallRuleActionBlock:
+			r.Action = RulesetRuleActionBlock
+		case MagicFirewallRuleActionLog:
+			r.Action = RulesetRuleActionLog
+		default:
+			return []MagicFirewallRule{}, fmt.Errorf("%w: %q", ErrUnsupportedMagicFirewallRuleAction, rule.Action)
+		}
+
+		params.Rules = append(params.Rules, r)
+	}
+
+	ruleset, err := api.UpdateEntrypointRuleset(ctx, rc, params)
+	if err != nil {
+		return []MagicFirewallRule{}, err
+	}
+
+	return magicFirewallRulesFromRuleset(ruleset)
+}
+
+// magicFirewallRulesFromRuleset converts the rules of a `magic_transit`
+// ruleset. Rules that can't be represented, such as skip rules with other
+// action parameters than skipping the current ruleset or rules with logging
+// settings, are reported instead of being converted so that they aren't
+// rewritten or deleted by a later update.
+func magicFirewallRulesFromRuleset(ruleset Ruleset) ([]MagicFirewallRule, error) {
+	rules := make([]MagicFirewallRule, 0, len(ruleset.Rules))
+	for i, r := range ruleset.Rules {
+		action, ok := magicFirewallRuleAction(r)
+		if !ok {
+			return []MagicFirewallRule{}, fmt.Errorf("%w: %q in rule %s", ErrUnsupportedMagicFirewallRuleAction, r.Action, r.ID)
+		}
+
+		rules = append(rules, MagicFirewallRule{
+			ID:          r.ID,
+			Ref:         r.Ref,
+			Expression:  r.Expression,
+			Action:      action,
+			Priority:    i + 1,
+			Description: r.Description,
+			Enabled:     r.Enabled,
+		})
+	}
+
+	return rules, nil
+}
+
+// magicFirewallRuleAction returns the MagicFirewallRuleAction of a ruleset
+// rule, which is only set when UpdateMagicFirewallRules would write the rule
+// back unchanged.
+func magicFirewallRuleAction(r RulesetRule) (MagicFirewallRuleAction, bool) {
+	if r.Logging != nil || r.RateLimit != nil || r.ExposedCredentialCheck != nil || r.ScoreThreshold != 0 {
+		return "", false
+	}
+
+	switch r.Action {
+	case RulesetRuleActionSkip:
+		if r.ActionParameters == nil || !reflect.DeepEqual(*r.ActionParameters, RulesetRuleActionParameters{Ruleset: "current"}) {
+			return "", false
+		}
+		return MagicFirewallRuleActionAllow, true
+	case RulesetRuleActionBlock:
+		return MagicFirewallRuleActionBlock, r.ActionParameters == nil
+	case RulesetRuleActionLog:
+		return MagicFirewallRuleActionLog, r.ActionParameters == nil
+	}
+
+	return "", false
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -316,3 +317,98 @@ func TestDeleteMagicFirewallRuleset(t *testing.T) {
 	err := client.DeleteMagicFirewallRuleset(context.Background(), testAccountID, "2c0fc9fa937b11eaa1b71c4d701ab86e")
 	assert.NoError(t, err)
 }
+
+func TestUpdateMagicFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"rules": [
+					{"action": "skip", "action_parameters": {"ruleset": "current"}, "expression": "ip.src in {192.0.2.0/24}", "description": "allow office"},
+					{"action": "log", "expression": "udp.dstport in {53}", "enabled": false},
+					{"action": "block", "expression": "tcp.dstport in {22}", "description": "block ssh"}
+				]
+			}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "root",
+				"phase": "magic_transit",
+				"rules": [
+					{"id": "1", "action": "skip", "action_parameters": {"ruleset": "current"}, "expression": "ip.src in {192.0.2.0/24}", "description": "allow office", "enabled": true},
+					{"id": "2", "action": "log", "expression": "udp.dstport in {53}", "enabled": false},
+					{"id": "3", "action": "block", "expression": "tcp.dstport in {22}", "description": "block ssh", "enabled": true}
+				]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	want := []MagicFirewallRule{
+		{ID: "1", Expression: "ip.src in {192.0.2.0/24}", Action: MagicFirewallRuleActionAllow, Priority: 1, Description: "allow office", Enabled: BoolPtr(true)},
+		{ID: "2", Expression: "udp.dstport in {53}", Action: MagicFirewallRuleActionLog, Priority: 2, Enabled: BoolPtr(false)},
+		{ID: "3", Expression: "tcp.dstport in {22}", Action: MagicFirewallRuleActionBlock, Priority: 3, Description: "block ssh", Enabled: BoolPtr(true)},
+	}
+
+	// Rules are sent in priority order.
+	rules, err := client.UpdateMagicFirewallRules(context.Background(), AccountIdentifier(testAccountID), []MagicFirewallRule{
+		{Expression: "tcp.dstport in {22}", Action: MagicFirewallRuleActionBlock, Priority: 10, Description: "block ssh"},
+		{Expression: "ip.src in {192.0.2.0/24}", Action: MagicFirewallRuleActionAllow, Priority: 1, Description: "allow office"},
+		{Expression: "udp.dstport in {53}", Action: MagicFirewallRuleActionLog, Priority: 5, Enabled: BoolPtr(false)},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, rules)
+	}
+
+	rules, err = client.GetMagicFirewallRules(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, rules)
+	}
+
+	_, err = client.UpdateMagicFirewallRules(context.Background(), AccountIdentifier(testAccountID), []MagicFirewallRule{
+		{Expression: "tcp.dstport in {22}", Action: "challenge"},
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedMagicFirewallRuleAction)
+
+	_, err = client.GetMagicFirewallRules(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
+
+func TestGetMagicFirewallRules_UnsupportedRules(t *testing.T) {
+	for name, rule := range map[string]string{
+		"skip phases":    `{"id": "1", "action": "skip", "action_parameters": {"phases": ["magic_transit_managed"]}, "expression": "ip.src in {192.0.2.0/24}"}`,
+		"skip rules":     `{"id": "1", "action": "skip", "action_parameters": {"ruleset": "current", "rules": {"4814384a9e5d4991b9815dcfc25d2f1f": ["e3a567afc347477d9702d9047e97d760"]}}, "expression": "ip.src in {192.0.2.0/24}"}`,
+		"skip without":   `{"id": "1", "action": "skip", "expression": "ip.src in {192.0.2.0/24}"}`,
+		"block logging":  `{"id": "1", "action": "block", "logging": {"enabled": false}, "expression": "tcp.dstport in {22}"}`,
+		"log parameters": `{"id": "1", "action": "log", "action_parameters": {"ruleset": "current"}, "expression": "tcp.dstport in {22}"}`,
+		"unknown action": `{"id": "1", "action": "execute", "action_parameters": {"id": "4814384a9e5d4991b9815dcfc25d2f1f"}, "expression": "true"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			updated := false
+			mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+				updated = updated || r.Method == http.MethodPut
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprintf(w, `{"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "phase": "magic_transit", "rules": [%s]}, "success": true, "errors": [], "messages": []}`, rule)
+			})
+
+			// A read-modify-write can't rewrite the rule.
+			_, err := client.GetMagicFirewallRules(context.Background(), AccountIdentifier(testAccountID))
+			assert.ErrorIs(t, err, ErrUnsupportedMagicFirewallRuleAction)
+			assert.False(t, updated)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingMagicNetworkMonitoringRuleID        = errors.New("required magic network monitoring rule ID missing")
+	ErrMissingMagicNetworkMonitoringRuleName      = errors.New("magic network monitoring rule requires a name")
+	ErrMissingMagicNetworkMonitoringRulePrefixes  = errors.New("magic network monitoring rule requires at least one prefix")
+	ErrMissingMagicNetworkMonitoringRuleThreshold = errors.New("magic network monitoring rule requires a bandwidth or packet threshold")
+	ErrInvalidMagicNetworkMonitoringRuleDuration  = errors.New("invalid magic network monitoring rule duration")
+)
+
+// MagicNetworkMonitoringRuleDuration is how long traffic must exceed a
+// rule's threshold before an alert is triggered.
+type MagicNetworkMonitoringRuleDuration string
+
+const (
+	MagicNetworkMonitoringRuleDuration1m  MagicNetworkMonitoringRuleDuration = "1m"
+	MagicNetworkMonitoringRuleDuration5m  MagicNetworkMonitoringRuleDuration = "5m"
+	MagicNetworkMonitoringRuleDuration10m MagicNetworkMonitoringRuleDuration = "10m"
+	MagicNetworkMonitoringRuleDuration15m MagicNetworkMonitoringRuleDuration = "15m"
+	MagicNetworkMonitoringRuleDuration20m MagicNetworkMonitoringRuleDuration = "20m"
+	MagicNetworkMonitoringRuleDuration30m MagicNetworkMonitoringRuleDuration = "30m"
+	MagicNetworkMonitoringRuleDuration45m MagicNetworkMonitoringRuleDuration = "45m"
+	MagicNetworkMonitoringRuleDuration60m MagicNetworkMonitoringRuleDuration = "60m"
+)
+
+// MagicNetworkMonitoringConfiguration is the account wide configuration of
+// the routers sending flow data to Magic Network Monitoring.
+type MagicNetworkMonitoringConfiguration struct {
+	Name string `json:"name"`
+	// DefaultSampling is the sampling rate, in packets, of the flow data
+	// sent by the routers.
+	DefaultSampling float64  `json:"default_sampling"`
+	RouterIPs       []string `json:"router_ips"`
+}
+
+// MagicNetworkMonitoringRule alerts when the traffic to Prefixes exceeds
+// BandwidthThreshold (bits per second) or PacketThreshold (packets per
+// second) for Duration.
+type MagicNetworkMonitoringRule struct {
+	ID                     string                             `json:"id,omitempty"`
+	Name                   string                             `json:"name"`
+	Prefixes               []string                           `json:"prefixes"`
+	BandwidthThreshold     float64                            `json:"bandwidth_threshold,omitempty"`
+	PacketThreshold        float64                            `json:"packet_threshold,omitempty"`
+	Duration               MagicNetworkMonitoringRuleDuration `json:"duration,omitempty"`
+	AutomaticAdvertisement *bool                              `json:"automatic_advertisement,omitempty"`
+}
+
+type MagicNetworkMonitoringConfigurationResponse struct {
+	Response
+	Result MagicNetworkMonitoringConfiguration `json:"result"`
+}
+
+type MagicNetworkMonitoringRuleResponse struct {
+	Response
+	Result MagicNetworkMonitoringRule `json:"result"`
+}
+
+type MagicNetworkMonitoringRulesResponse struct {
+	Response
+	Result []MagicNetworkMonitoringRule `json:"result"`
+}
+
+// GetMagicNetworkMonitoringConfiguration returns the Magic Network Monitoring
+// configuration of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-configuration-list-account-configuration
+func (api *API) GetMagicNetworkMonitoringConfiguration(ctx context.Context, rc *ResourceContainer) (MagicNetworkMonitoringConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicNetworkMonitoringConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicNetworkMonitoringConfiguration{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/config", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicNetworkMonitoringConfiguration{}, err
+	}
+
+	return unmarshalMagicNetworkMonitoringConfiguration(res)
+}
+
+// CreateMagicNetworkMonitoringConfiguration creates the Magic Network
+// Monitoring configuration of an account that doesn't have one yet.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-configuration-create-account-configuration
+func (api *API) CreateMagicNetworkMonitoringConfiguration(ctx context.Context, rc *ResourceContainer, config MagicNetworkMonitoringConfiguration) (MagicNetworkMonitoringConfiguration, error) {
+	return api.writeMagicNetworkMonitoringConfiguration(ctx, rc, http.MethodPost, config)
+}
+
+// UpdateMagicNetworkMonitoringConfiguration replaces the Magic Network
+// Monitoring configuration of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-configuration-update-an-entire-account-configuration
+func (api *API) UpdateMagicNetworkMonitoringConfiguration(ctx context.Context, rc *ResourceContainer, config MagicNetworkMonitoringConfiguration) (MagicNetworkMonitoringConfiguration, error) {
+	return api.writeMagicNetworkMonitoringConfiguration(ctx, rc, http.MethodPut, config)
+}
+
+func (api *API) writeMagicNetworkMonitoringConfiguration(ctx context.Context, rc *ResourceContainer, method string, config MagicNetworkMonitoringConfiguration) (MagicNetworkMonitoringConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicNetworkMonitoringConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicNetworkMonitoringConfiguration{}, ErrMissingAccountID
+	}
+
+	if config.RouterIPs == nil {
+		config.RouterIPs = []string{}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/config", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, method, uri, config)
+	if err != nil {
+		return MagicNetworkMonitoringConfiguration{}, err
+	}
+
+	return unmarshalMagicNetworkMonitoringConfiguration(res)
+}
+
+func unmarshalMagicNetworkMonitoringConfiguration(res []byte) (MagicNetworkMonitoringConfiguration, error) {
+	var r MagicNetworkMonitoringConfigurationResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return MagicNetworkMonitoringConfiguration{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListMagicNetworkMonitoringRules returns the Magic Network Monitoring rules
+// of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-rules-list-rules
+func (api *API) ListMagicNetworkMonitoringRules(ctx context.Context, rc *ResourceContainer) ([]MagicNetworkMonitoringRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return []MagicNetworkMonitoringRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []MagicNetworkMonitoringRule{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicNetworkMonitoringRule{}, err
+	}
+
+	var r MagicNetworkMonitoringRulesResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []MagicNetworkMonitoringRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetMagicNetworkMonitoringRule returns a single Magic Network Monitoring
+// rule.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-rules-get-rule
+func (api *API) GetMagicNetworkMonitoringRule(ctx context.Context, rc *ResourceContainer, ruleID string) (MagicNetworkMonitoringRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicNetworkMonitoringRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicNetworkMonitoringRule{}, ErrMissingAccountID
+	}
+
+	if ruleID == "" {
+		return MagicNetworkMonitoringRule{}, ErrMissingMagicNetworkMonitoringRuleID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules/%s", rc.Identifier, ruleID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	return unmarshalMagicNetworkMonitoringRule(res)
+}
+
+// CreateMagicNetworkMonitoringRule creates a rule alerting on traffic to the
+// rule's prefixes.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-rules-create-rules
+func (api *API) CreateMagicNetworkMonitoringRule(ctx context.Context, rc *ResourceContainer, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicNetworkMonitoringRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicNetworkMonitoringRule{}, ErrMissingAccountID
+	}
+
+	if err := validateMagicNetworkMonitoringRule(rule); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, rule)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	return unmarshalMagicNetworkMonitoringRule(res)
+}
+
+// UpdateMagicNetworkMonitoringRule replaces the rule with the ID of the
+// given rule.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-rules-update-rules
+func (api *API) UpdateMagicNetworkMonitoringRule(ctx context.Context, rc *ResourceContainer, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicNetworkMonitoringRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicNetworkMonitoringRule{}, ErrMissingAccountID
+	}
+
+	if rule.ID == "" {
+		return MagicNetworkMonitoringRule{}, ErrMissingMagicNetworkMonitoringRuleID
+	}
+
+	if err := validateMagicNetworkMonitoringRule(rule); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, rule)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	return unmarshalMagicNetworkMonitoringRule(res)
+}
+
+// DeleteMagicNetworkMonitoringRule deletes a Magic Network Monitoring rule.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-network-monitoring-rules-delete-rule
+func (api *API) DeleteMagicNetworkMonitoringRule(ctx context.Context, rc *ResourceContainer, ruleID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if ruleID == "" {
+		return ErrMissingMagicNetworkMonitoringRuleID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules/%s", rc.Identifier, ruleID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	return err
+}
+
+func unmarshalMagicNetworkMonitoringRule(res []byte) (MagicNetworkMonitoringRule, error) {
+	var r MagicNetworkMonitoringRuleResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+func validateMagicNetworkMonitoringRule(rule MagicNetworkMonitoringRule) error {
+	if rule.Name == "" {
+		return ErrMissingMagicNetworkMonitoringRuleName
+	}
+
+	if len(rule.Prefixes) == 0 {
+		return ErrMissingMagicNetworkMonitoringRulePrefixes
+	}
+
+	if rule.BandwidthThreshold <= 0 && rule.PacketThreshold <= 0 {
+		return ErrMissingMagicNetworkMonitoringRuleThreshold
+	}
+
+	switch rule.Duration {
+	case "",
+		MagicNetworkMonitoringRuleDuration1m,
+		MagicNetworkMonitoringRuleDuration5m,
+		MagicNetworkMonitoringRuleDuration10m,
+		MagicNetworkMonitoringRuleDuration15m,
+		MagicNetworkMonitoringRuleDuration20m,
+		MagicNetworkMonitoringRuleDuration30m,
+		MagicNetworkMonitoringRuleDuration45m,
+		MagicNetworkMonitoringRuleDuration60m:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidMagicNetworkMonitoringRuleDuration, rule.Duration)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMagicNetworkMonitoringRuleID = "2890e6fa406311ed9b5a23f70f6fb8cf"
+
+func TestGetMagicNetworkMonitoringConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/mnm/config", testAccountID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "name": "cloudflare user's account",
+    "default_sampling": 1,
+    "router_ips": ["203.0.113.1"]
+  }
+}`)
+	})
+
+	want := MagicNetworkMonitoringConfiguration{
+		Name:            "cloudflare user's account",
+		DefaultSampling: 1,
+		RouterIPs:       []string{"203.0.113.1"},
+	}
+
+	_, err := client.GetMagicNetworkMonitoringConfiguration(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	actual, err := client.GetMagicNetworkMonitoringConfiguration(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateMagicNetworkMonitoringRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/mnm/rules", testAccountID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "name": "edge-prefixes",
+  "prefixes": ["192.0.2.0/24"],
+  "bandwidth_threshold": 1000000000,
+  "duration": "1m"
+}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "%s",
+    "name": "edge-prefixes",
+    "prefixes": ["192.0.2.0/24"],
+    "bandwidth_threshold": 1000000000,
+    "duration": "1m",
+    "automatic_advertisement": false
+  }
+}`, testMagicNetworkMonitoringRuleID)
+	})
+
+	rule := MagicNetworkMonitoringRule{
+		Name:               "edge-prefixes",
+		Prefixes:           []string{"192.0.2.0/24"},
+		BandwidthThreshold: 1e9,
+		Duration:           MagicNetworkMonitoringRuleDuration1m,
+	}
+
+	want := rule
+	want.ID = testMagicNetworkMonitoringRuleID
+	want.AutomaticAdvertisement = BoolPtr(false)
+
+	actual, err := client.CreateMagicNetworkMonitoringRule(context.Background(), AccountIdentifier(testAccountID), rule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestValidateMagicNetworkMonitoringRule(t *testing.T) {
+	valid := MagicNetworkMonitoringRule{
+		Name:            "edge-prefixes",
+		Prefixes:        []string{"192.0.2.0/24"},
+		PacketThreshold: 10000,
+	}
+	assert.NoError(t, validateMagicNetworkMonitoringRule(valid))
+
+	noName := valid
+	noName.Name = ""
+	assert.ErrorIs(t, validateMagicNetworkMonitoringRule(noName), ErrMissingMagicNetworkMonitoringRuleName)
+
+	noPrefixes := valid
+	noPrefixes.Prefixes = nil
+	assert.ErrorIs(t, validateMagicNetworkMonitoringRule(noPrefixes), ErrMissingMagicNetworkMonitoringRulePrefixes)
+
+	noThreshold := valid
+	noThreshold.PacketThreshold = 0
+	assert.ErrorIs(t, validateMagicNetworkMonitoringRule(noThreshold), ErrMissingMagicNetworkMonitoringRuleThreshold)
+
+	badDuration := valid
+	badDuration.Duration = "2m"
+	assert.ErrorIs(t, validateMagicNetworkMonitoringRule(badDuration), ErrInvalidMagicNetworkMonitoringRuleDuration)
+}
+
+func TestUpdateMagicNetworkMonitoringRule_MissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateMagicNetworkMonitoringRule(context.Background(), AccountIdentifier(testAccountID), MagicNetworkMonitoringRule{Name: "edge-prefixes"})
+	assert.ErrorIs(t, err, ErrMissingMagicNetworkMonitoringRuleID)
+}
+
+func TestDeleteMagicNetworkMonitoringRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/mnm/rules/%s", testAccountID, testMagicNetworkMonitoringRuleID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+
+	err := client.DeleteMagicNetworkMonitoringRule(context.Background(), AccountIdentifier(testAccountID), testMagicNetworkMonitoringRuleID)
+	assert.NoError(t, err)
+}
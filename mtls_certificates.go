@@ -76,7 +76,9 @@ type ListMTLSCertificateAssociationsParams struct {
 }
 
 var (
-	ErrMissingCertificateID = errors.New("missing required certificate ID")
+	ErrMissingCertificateID    = errors.New("missing required certificate ID")
+	ErrMissingMTLSCertificates = errors.New("missing required PEM encoded certificates")
+	ErrMissingMTLSPrivateKey   = errors.New("a private key is required for leaf mTLS certificates")
 )
 
 // ListMTLSCertificates returns a list of all user-uploaded mTLS certificates.
@@ -91,8 +93,8 @@ func (api *API) ListMTLSCertificates(ctx context.Context, rc *ResourceContainer,
 		return []MTLSCertificate{}, ResultInfo{}, ErrMissingAccountID
 	}
 
-	uri := fmt.Sprintf("/accounts/%s/mtls_certificates", rc.Identifier)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, params)
+	uri := buildURI(fmt.Sprintf("/accounts/%s/mtls_certificates", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return []MTLSCertificate{}, ResultInfo{}, err
 	}
@@ -174,6 +176,14 @@ func (api *API) CreateMTLSCertificate(ctx context.Context, rc *ResourceContainer
 		return MTLSCertificate{}, ErrMissingAccountID
 	}
 
+	if params.Certificates == "" {
+		return MTLSCertificate{}, ErrMissingMTLSCertificates
+	}
+
+	if !params.CA && params.PrivateKey == "" {
+		return MTLSCertificate{}, ErrMissingMTLSPrivateKey
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/mtls_certificates", rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
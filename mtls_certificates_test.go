@@ -243,3 +243,37 @@ func TestDeleteMTLSCertificate(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestListMTLSCertificates_QueryParameters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/mtls_certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "10", r.URL.Query().Get("per_page"))
+		assert.Equal(t, "true", r.URL.Query().Get("ca"))
+		assert.Equal(t, int64(0), r.ContentLength)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [], "result_info": {"page": 2, "per_page": 10, "count": 0, "total_count": 10}}`)
+	})
+
+	_, resultInfo, err := client.ListMTLSCertificates(context.Background(), AccountIdentifier(testAccountID), ListMTLSCertificatesParams{
+		PaginationOptions: PaginationOptions{Page: 2, PerPage: 10},
+		CA:                true,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, resultInfo.Page)
+	}
+}
+
+func TestCreateMTLSCertificate_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CreateMTLSCertificate(context.Background(), AccountIdentifier(testAccountID), CreateMTLSCertificateParams{Name: "client-ca", CA: true})
+	assert.ErrorIs(t, err, ErrMissingMTLSCertificates)
+
+	_, err = client.CreateMTLSCertificate(context.Background(), AccountIdentifier(testAccountID), CreateMTLSCertificateParams{Name: "leaf", Certificates: "-----BEGIN CERTIFICATE-----"})
+	assert.ErrorIs(t, err, ErrMissingMTLSPrivateKey)
+}
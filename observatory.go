@@ -15,6 +15,7 @@ import (
 var (
 	ErrMissingObservatoryUrl    = errors.New("missing required page url")
 	ErrMissingObservatoryTestID = errors.New("missing required test id")
+	ErrObservatoryPageNotTested = errors.New("no tests found for page")
 )
 
 // ObservatoryPage describes all the tests for a web page.
@@ -103,6 +104,14 @@ type ObservatoryPagesResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-list-pages
 func (api *API) ListObservatoryPages(ctx context.Context, rc *ResourceContainer, params ListObservatoryPagesParams) ([]ObservatoryPage, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/speed_api/pages", rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -135,6 +144,14 @@ type ObservatoryPageTrendResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-list-page-trend
 func (api *API) GetObservatoryPageTrend(ctx context.Context, rc *ResourceContainer, params GetObservatoryPageTrendParams) (*ObservatoryPageTrend, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -157,7 +174,7 @@ var listObservatoryPageTestDefaultPageSize = 20
 
 type ListObservatoryPageTestParams struct {
 	URL    string `url:"-"`
-	Region string `url:"region"`
+	Region string `url:"region,omitempty"`
 	ResultInfo
 }
 
@@ -171,6 +188,14 @@ type ObservatoryPageTestsResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-list-test-history
 func (api *API) ListObservatoryPageTests(ctx context.Context, rc *ResourceContainer, params ListObservatoryPageTestParams) ([]ObservatoryPageTest, *ResultInfo, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, nil, ErrMissingObservatoryUrl
 	}
@@ -185,7 +210,7 @@ func (api *API) ListObservatoryPageTests(ctx context.Context, rc *ResourceContai
 		params.Page = 1
 	}
 	var tests []ObservatoryPageTest
-	var lastResultInfo ResultInfo
+	var r ObservatoryPageTestsResponse
 	for {
 		// cannot use buildURI because params.URL contains "/" that should be encoded and buildURI will double encode %2F into %252F
 		v, _ := query.Values(params)
@@ -194,18 +219,38 @@ func (api *API) ListObservatoryPageTests(ctx context.Context, rc *ResourceContai
 		if err != nil {
 			return nil, nil, err
 		}
-		var r ObservatoryPageTestsResponse
+		r = ObservatoryPageTestsResponse{}
 		err = json.Unmarshal(res, &r)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
 		}
 		tests = append(tests, r.Result...)
-		lastResultInfo = r.ResultInfo.Next()
+		params.ResultInfo = r.ResultInfo.Next()
 		if params.ResultInfo.Done() || !autoPaginate {
 			break
 		}
 	}
-	return tests, &lastResultInfo, nil
+	return tests, &r.ResultInfo, nil
+}
+
+// GetSpeedTestResult returns the most recent test of a page in any region,
+// including the Lighthouse reports for both the mobile and desktop strategy.
+// The test may still be running, so the State of each report should be checked
+// before relying on its metrics.
+//
+// API reference: https://api.cloudflare.com/#speed-list-test-history
+func (api *API) GetSpeedTestResult(ctx context.Context, rc *ResourceContainer, pageURL string) (*ObservatoryPageTest, error) {
+	tests, _, err := api.ListObservatoryPageTests(ctx, rc, ListObservatoryPageTestParams{
+		URL:        pageURL,
+		ResultInfo: ResultInfo{Page: 1, PerPage: 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tests) == 0 {
+		return nil, ErrObservatoryPageNotTested
+	}
+	return &tests[0], nil
 }
 
 type CreateObservatoryPageTestParams struct {
@@ -225,6 +270,14 @@ type ObservatoryPageTestResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-create-test
 func (api *API) CreateObservatoryPageTest(ctx context.Context, rc *ResourceContainer, params CreateObservatoryPageTestParams) (*ObservatoryPageTest, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -257,6 +310,14 @@ type ObservatoryCountResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-delete-tests
 func (api *API) DeleteObservatoryPageTests(ctx context.Context, rc *ResourceContainer, params DeleteObservatoryPageTestsParams) (*int, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -284,6 +345,14 @@ type GetObservatoryPageTestParams struct {
 //
 // API reference: https://api.cloudflare.com/#speed-get-test
 func (api *API) GetObservatoryPageTest(ctx context.Context, rc *ResourceContainer, params GetObservatoryPageTestParams) (*ObservatoryPageTest, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -323,6 +392,14 @@ type CreateObservatoryScheduledPageTestResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-create-scheduled-test
 func (api *API) CreateObservatoryScheduledPageTest(ctx context.Context, rc *ResourceContainer, params CreateObservatoryScheduledPageTestParams) (*ObservatoryScheduledPageTest, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -355,6 +432,14 @@ type ObservatoryScheduleResponse struct {
 //
 // API reference: https://api.cloudflare.com/#speed-get-scheduled-test
 func (api *API) GetObservatoryScheduledPageTest(ctx context.Context, rc *ResourceContainer, params GetObservatoryScheduledPageTestParams) (*ObservatorySchedule, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -382,6 +467,14 @@ type DeleteObservatoryScheduledPageTestParams struct {
 //
 // API reference: https://api.cloudflare.com/#speed-delete-scheduled-test
 func (api *API) DeleteObservatoryScheduledPageTest(ctx context.Context, rc *ResourceContainer, params DeleteObservatoryScheduledPageTestParams) (*int, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
 	if params.URL == "" {
 		return nil, ErrMissingObservatoryUrl
 	}
@@ -285,6 +285,72 @@ func TestListObservatoryPageTests(t *testing.T) {
 	}
 }
 
+func TestListObservatoryPageTestsAutoPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			  "success": true,
+			  "errors": [],
+			  "messages": [],
+			  "result": [%s],
+			  "result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2}
+			}
+		`, pageTestJSON, page)
+	}
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages/"+testURL+"/tests", handler)
+
+	_, _, err := client.ListObservatoryPageTests(context.Background(), AccountIdentifier(testAccountID), ListObservatoryPageTestParams{URL: testURL})
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	tests, _, err := client.ListObservatoryPageTests(context.Background(), ZoneIdentifier(testZoneID), ListObservatoryPageTestParams{
+		URL:    testURL,
+		Region: region,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ObservatoryPageTest{pageTest, pageTest}, tests)
+		assert.Equal(t, []string{"1", "2"}, pages)
+	}
+}
+
+func TestGetSpeedTestResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	result := pageTestJSON
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "page=1&per_page=1", r.URL.RawQuery)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			  "success": true,
+			  "errors": [],
+			  "messages": [],
+			  "result": [%s]
+			}
+		`, result)
+	}
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages/"+testURL+"/tests", handler)
+
+	_, err := client.GetSpeedTestResult(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingObservatoryUrl)
+
+	test, err := client.GetSpeedTestResult(context.Background(), ZoneIdentifier(testZoneID), testURL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &pageTest, test)
+	}
+
+	result = ""
+	_, err = client.GetSpeedTestResult(context.Background(), ZoneIdentifier(testZoneID), testURL)
+	assert.ErrorIs(t, err, ErrObservatoryPageNotTested)
+}
+
 func TestCreateObservatoryPageTest(t *testing.T) {
 	setup()
 	defer teardown()
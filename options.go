@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -18,6 +19,69 @@ func HTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithHTTPClient accepts a custom *http.Client for making API calls. When
+// provided, it takes precedence over `WithRequestTimeout` and `WithTransport`
+// regardless of the order the options are supplied in.
+func WithHTTPClient(client *http.Client) Option {
+	return HTTPClient(client)
+}
+
+// Doer sends HTTP requests, as implemented by *http.Client and by retrying
+// clients such as go-retryablehttp's StandardClient.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// WithDoer sends all API requests using doer. It takes precedence over
+// `WithHTTPClient`, `WithRequestTimeout` and `WithTransport`. The client's own
+// retry policy is disabled as retrying is expected to be handled by the Doer,
+// so responses it returns, including rate limited ones, are treated as final.
+func WithDoer(doer Doer) Option {
+	return func(api *API) error {
+		if doer == nil {
+			return errors.New("doer cannot be nil")
+		}
+		api.doer = doer
+		return nil
+	}
+}
+
+// WithZoneCache remembers the zone IDs returned by `ZoneIDByName` for ttl,
+// saving the lookup for services working with a fixed set of zones. Up to
+// 1000 zone names are kept and a name is forgotten when its zone is deleted
+// with `DeleteZone`. Zones are not cached by default.
+func WithZoneCache(ttl time.Duration) Option {
+	return func(api *API) error {
+		if ttl <= 0 {
+			return errors.New("zone cache TTL must be positive")
+		}
+		api.zoneCache = newZoneCache(ttl)
+		return nil
+	}
+}
+
+// WithRequestTimeout bounds the time taken by each HTTP request, including
+// reading the response body. It is ignored if `WithHTTPClient` is used.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(api *API) error {
+		if timeout < 0 {
+			return errors.New("request timeout cannot be negative")
+		}
+		api.requestTimeout = timeout
+		return nil
+	}
+}
+
+// WithTransport sets the *http.Transport used for API calls, allowing the
+// connection pool to be sized for the expected concurrency. It is ignored if
+// `WithHTTPClient` is used.
+func WithTransport(transport *http.Transport) Option {
+	return func(api *API) error {
+		api.transport = transport
+		return nil
+	}
+}
+
 // Headers allows you to set custom HTTP headers when making API calls (e.g. for
 // satisfying HTTP proxies, or for debugging).
 func Headers(headers http.Header) Option {
@@ -54,6 +118,18 @@ func UsingRetryPolicy(maxRetries int, minRetryDelaySecs int, maxRetryDelaySecs i
 	}
 }
 
+// WithRetryPolicy replaces the retry policy, allowing the number of retries,
+// their delays and which status codes and methods are retried to be set.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(api *API) error {
+		if policy.MaxRetries < 0 {
+			return errors.New("max retries cannot be negative")
+		}
+		api.retryPolicy = policy
+		return nil
+	}
+}
+
 // UsingLogger can be set if you want to get log output from this API instance
 // By default no log output is emitted.
 func UsingLogger(logger Logger) Option {
@@ -63,6 +139,21 @@ func UsingLogger(logger Logger) Option {
 	}
 }
 
+// WithLogger sets the logger used by this API instance and logs the method,
+// URL, status, duration and redacted authentication header of every request
+// made. Sensitive values such as credentials in the URL are masked.
+func WithLogger(logger Logger) Option {
+	return func(api *API) error {
+		if logger == nil {
+			return errors.New("logger cannot be nil")
+		}
+
+		api.logger = logger
+		api.logRequests = true
+		return nil
+	}
+}
+
 // UserAgent can be set if you want to send a software name and version for HTTP access logs.
 // It is recommended to set it in order to help future Customer Support diagnostics
 // and prevent collateral damage by sharing generic User-Agent string with abusive users.
@@ -82,6 +173,16 @@ func BaseURL(baseURL string) Option {
 	}
 }
 
+// WithResponseStreaming allows endpoints that support it (such as
+// `ZoneExportReader`) to return the response body as a stream rather than
+// reading it into memory first. Other endpoints are unaffected.
+func WithResponseStreaming(enabled bool) Option {
+	return func(api *API) error {
+		api.responseStreaming = enabled
+		return nil
+	}
+}
+
 func Debug(debug bool) Option {
 	return func(api *API) error {
 		api.Debug = debug
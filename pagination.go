@@ -1,9 +1,26 @@
 package cloudflare
 
 import (
+	"errors"
+	"fmt"
 	"math"
 )
 
+// ErrInvalidPerPage is for when the requested page size is outside of the
+// range accepted by the endpoint, which the API would reject with a 400.
+var ErrInvalidPerPage = errors.New("per_page is out of range for this endpoint")
+
+// Page size limits of the list endpoints.
+const (
+	// accessMaxPerPage is the largest page size of the Access list endpoints.
+	accessMaxPerPage = 1000
+
+	// dnsRecordsMinPerPage and dnsRecordsMaxPerPage bound the page size of
+	// the DNS records list endpoint.
+	dnsRecordsMinPerPage = 5
+	dnsRecordsMaxPerPage = 5000000
+)
+
 // Look first for total_pages, but if total_count and per_page are set then use that to get page count.
 func (p ResultInfo) getTotalPages() int {
 	totalPages := p.TotalPages
@@ -57,3 +74,24 @@ func (p ResultInfo) HasMorePages() bool {
 
 	return p.Page >= 1 && p.Page < totalPages
 }
+
+// NextCursor returns the cursor of the next page of a cursor paginated API
+// response, or an empty string for the last page. Endpoints return the
+// cursor either in `cursors.after` or in `cursor`.
+func (p ResultInfo) NextCursor() string {
+	if p.Cursors.After != "" {
+		return p.Cursors.After
+	}
+
+	return p.Cursor
+}
+
+// checkPerPage returns ErrInvalidPerPage when a page size was requested that
+// is outside of min and max. Zero leaves the page size to the default.
+func checkPerPage(perPage, min, max int) error {
+	if perPage == 0 || (perPage >= min && perPage <= max) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d is not between %d and %d", ErrInvalidPerPage, perPage, min, max)
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -128,3 +129,50 @@ func TestPagination_HasMorePages(t *testing.T) {
 		})
 	}
 }
+
+func TestPagination_NextCursor(t *testing.T) {
+	testCases := map[string]struct {
+		r        ResultInfo
+		expected string
+	}{
+		"last page": {
+			r:        ResultInfo{},
+			expected: "",
+		},
+		"cursor": {
+			r:        ResultInfo{Cursor: "abc"},
+			expected: "abc",
+		},
+		"after cursor": {
+			r:        ResultInfo{Cursors: ResultInfoCursors{After: "def"}},
+			expected: "def",
+		},
+		"after cursor takes precedence": {
+			r:        ResultInfo{Cursor: "abc", Cursors: ResultInfoCursors{After: "def"}},
+			expected: "def",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.r.NextCursor())
+		})
+	}
+}
+
+func TestCheckPerPage(t *testing.T) {
+	assert.NoError(t, checkPerPage(0, 5, 100))
+	assert.NoError(t, checkPerPage(5, 5, 100))
+	assert.NoError(t, checkPerPage(100, 5, 100))
+	assert.ErrorIs(t, checkPerPage(4, 5, 100), ErrInvalidPerPage)
+	assert.ErrorIs(t, checkPerPage(500, 5, 100), ErrInvalidPerPage)
+
+	setup()
+	defer teardown()
+
+	_, _, err := client.ListAccessApplications(context.Background(), AccountIdentifier(testAccountID), ListAccessApplicationsParams{ResultInfo: ResultInfo{PerPage: 5000}})
+	assert.ErrorIs(t, err, ErrInvalidPerPage)
+
+	_, _, err = client.ListDNSRecords(context.Background(), ZoneIdentifier(testZoneID), ListDNSRecordsParams{ResultInfo: ResultInfo{PerPage: 1}})
+	assert.ErrorIs(t, err, ErrInvalidPerPage)
+}
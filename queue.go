@@ -13,6 +13,7 @@ import (
 var (
 	ErrMissingQueueName         = errors.New("required queue name is missing")
 	ErrMissingQueueConsumerName = errors.New("required queue consumer name is missing")
+	ErrMissingQueueID           = errors.New("required queue ID is missing")
 )
 
 type Queue struct {
@@ -102,10 +103,63 @@ type DeleteQueueConsumerParams struct {
 	QueueName, ConsumerName string
 }
 
+// QueueMessage is a message read by an HTTP pull consumer. The message must
+// be acknowledged or retried with its LeaseID before the visibility timeout
+// expires, otherwise it is delivered again.
+type QueueMessage struct {
+	ID          string            `json:"id"`
+	Body        string            `json:"body"`
+	LeaseID     string            `json:"lease_id"`
+	Attempts    int               `json:"attempts"`
+	TimestampMs int64             `json:"timestamp_ms"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type PullQueueMessagesParams struct {
+	BatchSize           int `json:"batch_size,omitempty"`
+	VisibilityTimeoutMs int `json:"visibility_timeout_ms,omitempty"`
+}
+
+type PullQueueMessagesResponse struct {
+	Response
+	Result struct {
+		Messages []QueueMessage `json:"messages"`
+	} `json:"result"`
+}
+
+type QueueMessageAck struct {
+	LeaseID string `json:"lease_id"`
+}
+
+type QueueMessageRetry struct {
+	LeaseID      string `json:"lease_id"`
+	DelaySeconds int    `json:"delay_seconds,omitempty"`
+}
+
+type AckQueueMessagesParams struct {
+	Acks    []QueueMessageAck   `json:"acks"`
+	Retries []QueueMessageRetry `json:"retries"`
+}
+
+type QueueMessagesAckResult struct {
+	AckCount   int      `json:"ackCount"`
+	RetryCount int      `json:"retryCount"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+type AckQueueMessagesResponse struct {
+	Response
+	Result QueueMessagesAckResult `json:"result"`
+}
+
 // ListQueues returns the queues owned by an account.
 //
 // API reference: https://api.cloudflare.com/#queue-list-queues
 func (api *API) ListQueues(ctx context.Context, rc *ResourceContainer, params ListQueuesParams) ([]Queue, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []Queue{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []Queue{}, &ResultInfo{}, ErrMissingAccountID
 	}
@@ -152,6 +206,10 @@ func (api *API) ListQueues(ctx context.Context, rc *ResourceContainer, params Li
 //
 // API reference: https://api.cloudflare.com/#queue-create-queue
 func (api *API) CreateQueue(ctx context.Context, rc *ResourceContainer, queue CreateQueueParams) (Queue, error) {
+	if rc.Level != AccountRouteLevel {
+		return Queue{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Queue{}, ErrMissingAccountID
 	}
@@ -178,6 +236,10 @@ func (api *API) CreateQueue(ctx context.Context, rc *ResourceContainer, queue Cr
 //
 // API reference: https://api.cloudflare.com/#queue-delete-queue
 func (api *API) DeleteQueue(ctx context.Context, rc *ResourceContainer, queueName string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
@@ -197,6 +259,10 @@ func (api *API) DeleteQueue(ctx context.Context, rc *ResourceContainer, queueNam
 //
 // API reference: https://api.cloudflare.com/#queue-get-queue
 func (api *API) GetQueue(ctx context.Context, rc *ResourceContainer, queueName string) (Queue, error) {
+	if rc.Level != AccountRouteLevel {
+		return Queue{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Queue{}, ErrMissingAccountID
 	}
@@ -224,6 +290,10 @@ func (api *API) GetQueue(ctx context.Context, rc *ResourceContainer, queueName s
 //
 // API reference: https://api.cloudflare.com/#queue-update-queue
 func (api *API) UpdateQueue(ctx context.Context, rc *ResourceContainer, params UpdateQueueParams) (Queue, error) {
+	if rc.Level != AccountRouteLevel {
+		return Queue{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Queue{}, ErrMissingAccountID
 	}
@@ -250,6 +320,10 @@ func (api *API) UpdateQueue(ctx context.Context, rc *ResourceContainer, params U
 //
 // API reference: https://api.cloudflare.com/#queue-list-queue-consumers
 func (api *API) ListQueueConsumers(ctx context.Context, rc *ResourceContainer, params ListQueueConsumersParams) ([]QueueConsumer, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []QueueConsumer{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []QueueConsumer{}, &ResultInfo{}, ErrMissingAccountID
 	}
@@ -300,6 +374,10 @@ func (api *API) ListQueueConsumers(ctx context.Context, rc *ResourceContainer, p
 //
 // API reference: https://api.cloudflare.com/#queue-create-queue-consumer
 func (api *API) CreateQueueConsumer(ctx context.Context, rc *ResourceContainer, params CreateQueueConsumerParams) (QueueConsumer, error) {
+	if rc.Level != AccountRouteLevel {
+		return QueueConsumer{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return QueueConsumer{}, ErrMissingAccountID
 	}
@@ -326,6 +404,10 @@ func (api *API) CreateQueueConsumer(ctx context.Context, rc *ResourceContainer,
 //
 // API reference: https://api.cloudflare.com/#queue-delete-queue-consumer
 func (api *API) DeleteQueueConsumer(ctx context.Context, rc *ResourceContainer, params DeleteQueueConsumerParams) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
@@ -351,6 +433,10 @@ func (api *API) DeleteQueueConsumer(ctx context.Context, rc *ResourceContainer,
 //
 // API reference: https://api.cloudflare.com/#queue-update-queue-consumer
 func (api *API) UpdateQueueConsumer(ctx context.Context, rc *ResourceContainer, params UpdateQueueConsumerParams) (QueueConsumer, error) {
+	if rc.Level != AccountRouteLevel {
+		return QueueConsumer{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return QueueConsumer{}, ErrMissingAccountID
 	}
@@ -376,3 +462,75 @@ func (api *API) UpdateQueueConsumer(ctx context.Context, rc *ResourceContainer,
 	}
 	return r.Result, nil
 }
+
+// PullQueueMessages reads a batch of messages from a queue with an HTTP pull
+// consumer. The messages are hidden from other consumers until the visibility
+// timeout expires.
+//
+// API reference: https://developers.cloudflare.com/api/operations/queue-v2-messages-pull
+func (api *API) PullQueueMessages(ctx context.Context, rc *ResourceContainer, queueID string, params PullQueueMessagesParams) ([]QueueMessage, error) {
+	if rc.Level != AccountRouteLevel {
+		return []QueueMessage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []QueueMessage{}, ErrMissingAccountID
+	}
+
+	if queueID == "" {
+		return []QueueMessage{}, ErrMissingQueueID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/queues/%s/messages/pull", rc.Identifier, queueID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return []QueueMessage{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r PullQueueMessagesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []QueueMessage{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result.Messages, nil
+}
+
+// AckQueueMessages acknowledges messages read by PullQueueMessages so they
+// are removed from the queue, and marks others to be retried, optionally
+// after a delay.
+//
+// API reference: https://developers.cloudflare.com/api/operations/queue-v2-messages-ack
+func (api *API) AckQueueMessages(ctx context.Context, rc *ResourceContainer, queueID string, params AckQueueMessagesParams) (QueueMessagesAckResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return QueueMessagesAckResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return QueueMessagesAckResult{}, ErrMissingAccountID
+	}
+
+	if queueID == "" {
+		return QueueMessagesAckResult{}, ErrMissingQueueID
+	}
+
+	if params.Acks == nil {
+		params.Acks = []QueueMessageAck{}
+	}
+
+	if params.Retries == nil {
+		params.Retries = []QueueMessageRetry{}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/queues/%s/messages/ack", rc.Identifier, queueID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return QueueMessagesAckResult{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r AckQueueMessagesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return QueueMessagesAckResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -483,3 +484,80 @@ func TestQueue_UpdateConsumer(t *testing.T) {
 		assert.Equal(t, testQueueConsumer(), result)
 	}
 }
+
+func TestQueue_PullMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/queues/%s/messages/pull", testAccountID, testQueueID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"batch_size": 10, "visibility_timeout_ms": 30000}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result": {
+			"messages": [
+			  {
+				"id": "b01b5594f784d0165c2985833f5660dd",
+				"body": "hello world",
+				"lease_id": "eyJhbGciOiJkaXIiLCJlbmMiOiJBMjU2Q0JDLUhTNTEyIn0",
+				"attempts": 1,
+				"timestamp_ms": 1710950954154,
+				"metadata": {"CF-Content-Type": "text"}
+			  }
+			]
+		  }
+		}`)
+	})
+
+	_, err := client.PullQueueMessages(context.Background(), AccountIdentifier(testAccountID), "", PullQueueMessagesParams{})
+	assert.ErrorIs(t, err, ErrMissingQueueID)
+
+	want := []QueueMessage{{
+		ID:          "b01b5594f784d0165c2985833f5660dd",
+		Body:        "hello world",
+		LeaseID:     "eyJhbGciOiJkaXIiLCJlbmMiOiJBMjU2Q0JDLUhTNTEyIn0",
+		Attempts:    1,
+		TimestampMs: 1710950954154,
+		Metadata:    map[string]string{"CF-Content-Type": "text"},
+	}}
+
+	result, err := client.PullQueueMessages(context.Background(), AccountIdentifier(testAccountID), testQueueID, PullQueueMessagesParams{BatchSize: 10, VisibilityTimeoutMs: 30000})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, result)
+	}
+}
+
+func TestQueue_AckMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/queues/%s/messages/ack", testAccountID, testQueueID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"acks": [{"lease_id": "lease-1"}], "retries": []}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result": {
+			"ackCount": 1,
+			"retryCount": 0,
+			"warnings": []
+		  }
+		}`)
+	})
+
+	result, err := client.AckQueueMessages(context.Background(), AccountIdentifier(testAccountID), testQueueID, AckQueueMessagesParams{
+		Acks: []QueueMessageAck{{LeaseID: "lease-1"}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, QueueMessagesAckResult{AckCount: 1, Warnings: []string{}}, result)
+	}
+}
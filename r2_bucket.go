@@ -55,6 +55,10 @@ type R2BucketResponse struct {
 
 // ListR2Buckets Lists R2 buckets.
 func (api *API) ListR2Buckets(ctx context.Context, rc *ResourceContainer, params ListR2BucketsParams) ([]R2Bucket, error) {
+	if rc.Level != AccountRouteLevel {
+		return []R2Bucket{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []R2Bucket{}, ErrMissingAccountID
 	}
@@ -78,6 +82,10 @@ func (api *API) ListR2Buckets(ctx context.Context, rc *ResourceContainer, params
 //
 // API reference: https://api.cloudflare.com/#r2-bucket-create-bucket
 func (api *API) CreateR2Bucket(ctx context.Context, rc *ResourceContainer, params CreateR2BucketParameters) (R2Bucket, error) {
+	if rc.Level != AccountRouteLevel {
+		return R2Bucket{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return R2Bucket{}, ErrMissingAccountID
 	}
@@ -105,6 +113,10 @@ func (api *API) CreateR2Bucket(ctx context.Context, rc *ResourceContainer, param
 //
 // API reference: https://api.cloudflare.com/#r2-bucket-get-bucket
 func (api *API) GetR2Bucket(ctx context.Context, rc *ResourceContainer, bucketName string) (R2Bucket, error) {
+	if rc.Level != AccountRouteLevel {
+		return R2Bucket{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return R2Bucket{}, ErrMissingAccountID
 	}
@@ -132,6 +144,10 @@ func (api *API) GetR2Bucket(ctx context.Context, rc *ResourceContainer, bucketNa
 //
 // API reference: https://api.cloudflare.com/#r2-bucket-delete-bucket
 func (api *API) DeleteR2Bucket(ctx context.Context, rc *ResourceContainer, bucketName string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
@@ -0,0 +1,138 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrInvalidR2BucketLockCondition = errors.New("bucket lock condition must be Age with a positive max age, Date with a date or Indefinite")
+)
+
+// R2BucketLockConditionType is how long objects matched by a bucket lock
+// rule are retained for.
+type R2BucketLockConditionType string
+
+const (
+	// R2BucketLockConditionAge retains objects until they are older than
+	// MaxAgeSeconds.
+	R2BucketLockConditionAge R2BucketLockConditionType = "Age"
+	// R2BucketLockConditionDate retains objects until Date.
+	R2BucketLockConditionDate R2BucketLockConditionType = "Date"
+	// R2BucketLockConditionIndefinite retains objects forever.
+	R2BucketLockConditionIndefinite R2BucketLockConditionType = "Indefinite"
+)
+
+// R2BucketLockCondition is the retention period of a bucket lock rule.
+type R2BucketLockCondition struct {
+	Type          R2BucketLockConditionType `json:"type"`
+	MaxAgeSeconds int                       `json:"maxAgeSeconds,omitempty"`
+	// Date is formatted as an ISO 8601 date, e.g. `2030-01-31`.
+	Date string `json:"date,omitempty"`
+}
+
+// R2BucketLockRule prevents objects with keys beginning with Prefix from
+// being deleted or overwritten until the Condition is met.
+type R2BucketLockRule struct {
+	ID        string                `json:"id"`
+	Enabled   bool                  `json:"enabled"`
+	Prefix    string                `json:"prefix,omitempty"`
+	Condition R2BucketLockCondition `json:"condition"`
+}
+
+// R2BucketLockConfiguration is the set of bucket lock rules of a bucket.
+type R2BucketLockConfiguration struct {
+	Rules []R2BucketLockRule `json:"rules"`
+}
+
+type R2BucketLockConfigurationResponse struct {
+	Response
+	Result R2BucketLockConfiguration `json:"result"`
+}
+
+// GetR2BucketLockConfiguration returns the object retention rules of a
+// bucket.
+//
+// API reference: https://developers.cloudflare.com/api/operations/r2-get-bucket-lock-configuration
+func (api *API) GetR2BucketLockConfiguration(ctx context.Context, rc *ResourceContainer, bucketName string) (R2BucketLockConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return R2BucketLockConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return R2BucketLockConfiguration{}, ErrMissingAccountID
+	}
+
+	if bucketName == "" {
+		return R2BucketLockConfiguration{}, ErrMissingBucketName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/r2/buckets/%s/lock", rc.Identifier, bucketName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return R2BucketLockConfiguration{}, err
+	}
+
+	var r R2BucketLockConfigurationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return R2BucketLockConfiguration{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// PutR2BucketLockConfiguration replaces the object retention rules of a
+// bucket. Passing no rules removes all retention from the bucket.
+//
+// API reference: https://developers.cloudflare.com/api/operations/r2-put-bucket-lock-configuration
+func (api *API) PutR2BucketLockConfiguration(ctx context.Context, rc *ResourceContainer, bucketName string, rules []R2BucketLockRule) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if bucketName == "" {
+		return ErrMissingBucketName
+	}
+
+	for _, rule := range rules {
+		if err := validateR2BucketLockCondition(rule.Condition); err != nil {
+			return fmt.Errorf("rule %q: %w", rule.ID, err)
+		}
+	}
+
+	if rules == nil {
+		rules = []R2BucketLockRule{}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/r2/buckets/%s/lock", rc.Identifier, bucketName)
+	_, err := api.makeRequestContext(ctx, http.MethodPut, uri, R2BucketLockConfiguration{Rules: rules})
+
+	return err
+}
+
+func validateR2BucketLockCondition(condition R2BucketLockCondition) error {
+	switch condition.Type {
+	case R2BucketLockConditionAge:
+		if condition.MaxAgeSeconds <= 0 {
+			return ErrInvalidR2BucketLockCondition
+		}
+	case R2BucketLockConditionDate:
+		if condition.Date == "" {
+			return ErrInvalidR2BucketLockCondition
+		}
+	case R2BucketLockConditionIndefinite:
+	default:
+		return ErrInvalidR2BucketLockCondition
+	}
+
+	return nil
+}
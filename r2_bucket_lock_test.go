@@ -0,0 +1,84 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestR2_GetBucketLockConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/r2/buckets/%s/lock", testAccountID, testBucketName), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "rules": [
+      {
+        "id": "audit-logs",
+        "enabled": true,
+        "prefix": "logs/",
+        "condition": {"type": "Age", "maxAgeSeconds": 2592000}
+      },
+      {
+        "id": "legal-hold",
+        "enabled": false,
+        "condition": {"type": "Indefinite"}
+      }
+    ]
+  }
+}`)
+	})
+
+	want := R2BucketLockConfiguration{
+		Rules: []R2BucketLockRule{
+			{
+				ID:        "audit-logs",
+				Enabled:   true,
+				Prefix:    "logs/",
+				Condition: R2BucketLockCondition{Type: R2BucketLockConditionAge, MaxAgeSeconds: 2592000},
+			},
+			{
+				ID:        "legal-hold",
+				Condition: R2BucketLockCondition{Type: R2BucketLockConditionIndefinite},
+			},
+		},
+	}
+
+	actual, err := client.GetR2BucketLockConfiguration(context.Background(), AccountIdentifier(testAccountID), testBucketName)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestR2_PutBucketLockConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/r2/buckets/%s/lock", testAccountID, testBucketName), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rules": [{"id": "contracts", "enabled": true, "prefix": "contracts/", "condition": {"type": "Date", "date": "2030-01-31"}}]}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	err := client.PutR2BucketLockConfiguration(context.Background(), AccountIdentifier(testAccountID), testBucketName, []R2BucketLockRule{
+		{ID: "contracts", Enabled: true, Condition: R2BucketLockCondition{Type: R2BucketLockConditionAge}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidR2BucketLockCondition)
+
+	err = client.PutR2BucketLockConfiguration(context.Background(), AccountIdentifier(testAccountID), testBucketName, []R2BucketLockRule{
+		{ID: "contracts", Enabled: true, Prefix: "contracts/", Condition: R2BucketLockCondition{Type: R2BucketLockConditionDate, Date: "2030-01-31"}},
+	})
+	assert.NoError(t, err)
+}
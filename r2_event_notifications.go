@@ -0,0 +1,168 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingR2EventNotificationQueueID = errors.New("required queue ID missing")
+	ErrMissingR2EventNotificationRules   = errors.New("at least one event notification rule is required")
+	ErrMissingR2EventNotificationActions = errors.New("event notification rule requires at least one action")
+)
+
+// R2EventNotificationAction is an R2 object operation that triggers an event
+// notification.
+type R2EventNotificationAction string
+
+const (
+	R2EventNotificationActionPutObject               R2EventNotificationAction = "PutObject"
+	R2EventNotificationActionCopyObject              R2EventNotificationAction = "CopyObject"
+	R2EventNotificationActionDeleteObject            R2EventNotificationAction = "DeleteObject"
+	R2EventNotificationActionCompleteMultipartUpload R2EventNotificationAction = "CompleteMultipartUpload"
+	R2EventNotificationActionLifecycleDeletion       R2EventNotificationAction = "LifecycleDeletion"
+)
+
+// R2EventNotificationRule describes which object operations, optionally
+// limited to keys with a prefix and/or suffix, are sent to a queue.
+type R2EventNotificationRule struct {
+	RuleID      string                      `json:"ruleId,omitempty"`
+	Actions     []R2EventNotificationAction `json:"actions"`
+	Prefix      string                      `json:"prefix,omitempty"`
+	Suffix      string                      `json:"suffix,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	CreatedAt   string                      `json:"createdAt,omitempty"`
+}
+
+// R2EventNotificationQueue is a queue receiving event notifications and the
+// rules that send events to it.
+type R2EventNotificationQueue struct {
+	QueueID   string                    `json:"queueId"`
+	QueueName string                    `json:"queueName"`
+	Rules     []R2EventNotificationRule `json:"rules"`
+}
+
+// R2EventNotificationConfiguration is the event notification configuration
+// of a bucket.
+type R2EventNotificationConfiguration struct {
+	BucketName string                     `json:"bucketName"`
+	Queues     []R2EventNotificationQueue `json:"queues"`
+}
+
+type R2EventNotificationConfigurationResponse struct {
+	Response
+	Result R2EventNotificationConfiguration `json:"result"`
+}
+
+type r2EventNotificationRulesRequest struct {
+	Rules []R2EventNotificationRule `json:"rules,omitempty"`
+}
+
+type r2EventNotificationRuleIDsRequest struct {
+	RuleIDs []string `json:"ruleIds,omitempty"`
+}
+
+// GetR2EventNotificationConfiguration returns the event notification rules of
+// a bucket, grouped by destination queue.
+//
+// API reference: https://developers.cloudflare.com/api/operations/r2-get-event-notification-config
+func (api *API) GetR2EventNotificationConfiguration(ctx context.Context, rc *ResourceContainer, bucketName string) (R2EventNotificationConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return R2EventNotificationConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return R2EventNotificationConfiguration{}, ErrMissingAccountID
+	}
+
+	if bucketName == "" {
+		return R2EventNotificationConfiguration{}, ErrMissingBucketName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration", rc.Identifier, bucketName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return R2EventNotificationConfiguration{}, err
+	}
+
+	var r R2EventNotificationConfigurationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return R2EventNotificationConfiguration{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateR2EventNotificationRule sends events matching the rules for objects in
+// the bucket to the queue.
+//
+// API reference: https://developers.cloudflare.com/api/operations/r2-put-event-notification-config
+func (api *API) CreateR2EventNotificationRule(ctx context.Context, rc *ResourceContainer, bucketName, queueID string, rules []R2EventNotificationRule) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if bucketName == "" {
+		return ErrMissingBucketName
+	}
+
+	if queueID == "" {
+		return ErrMissingR2EventNotificationQueueID
+	}
+
+	if len(rules) == 0 {
+		return ErrMissingR2EventNotificationRules
+	}
+
+	for _, rule := range rules {
+		if len(rule.Actions) == 0 {
+			return ErrMissingR2EventNotificationActions
+		}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration/queues/%s", rc.Identifier, bucketName, queueID)
+	_, err := api.makeRequestContext(ctx, http.MethodPut, uri, r2EventNotificationRulesRequest{Rules: rules})
+
+	return err
+}
+
+// DeleteR2EventNotificationRules stops sending events from the bucket to the
+// queue. When ruleIDs is empty all of the rules for the queue are removed.
+//
+// API reference: https://developers.cloudflare.com/api/operations/r2-delete-event-notification-config
+func (api *API) DeleteR2EventNotificationRules(ctx context.Context, rc *ResourceContainer, bucketName, queueID string, ruleIDs []string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if bucketName == "" {
+		return ErrMissingBucketName
+	}
+
+	if queueID == "" {
+		return ErrMissingR2EventNotificationQueueID
+	}
+
+	var params interface{}
+	if len(ruleIDs) > 0 {
+		params = r2EventNotificationRuleIDsRequest{RuleIDs: ruleIDs}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration/queues/%s", rc.Identifier, bucketName, queueID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, params)
+
+	return err
+}
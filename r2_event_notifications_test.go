@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testR2QueueID = "11111aa1111a11aa11aa111111aaaaa1"
+
+func TestR2_GetEventNotificationConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration", testAccountID, testBucketName), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "bucketName": "example-bucket",
+    "queues": [
+      {
+        "queueId": "11111aa1111a11aa11aa111111aaaaa1",
+        "queueName": "uploads",
+        "rules": [
+          {
+            "ruleId": "rule-1",
+            "actions": ["PutObject", "CompleteMultipartUpload"],
+            "prefix": "images/",
+            "suffix": ".png",
+            "createdAt": "2024-09-19T21:54:48.405Z"
+          }
+        ]
+      }
+    ]
+  }
+}`)
+	})
+
+	_, err := client.GetR2EventNotificationConfiguration(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingBucketName)
+
+	want := R2EventNotificationConfiguration{
+		BucketName: testBucketName,
+		Queues: []R2EventNotificationQueue{{
+			QueueID:   testR2QueueID,
+			QueueName: "uploads",
+			Rules: []R2EventNotificationRule{{
+				RuleID:    "rule-1",
+				Actions:   []R2EventNotificationAction{R2EventNotificationActionPutObject, R2EventNotificationActionCompleteMultipartUpload},
+				Prefix:    "images/",
+				Suffix:    ".png",
+				CreatedAt: "2024-09-19T21:54:48.405Z",
+			}},
+		}},
+	}
+
+	actual, err := client.GetR2EventNotificationConfiguration(context.Background(), AccountIdentifier(testAccountID), testBucketName)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestR2_CreateEventNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration/queues/%s", testAccountID, testBucketName, testR2QueueID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rules": [{"actions": ["PutObject"], "prefix": "images/", "suffix": ".png"}, {"actions": ["DeleteObject"]}]}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	rules := []R2EventNotificationRule{
+		{Actions: []R2EventNotificationAction{R2EventNotificationActionPutObject}, Prefix: "images/", Suffix: ".png"},
+		{Actions: []R2EventNotificationAction{R2EventNotificationActionDeleteObject}},
+	}
+
+	err := client.CreateR2EventNotificationRule(context.Background(), AccountIdentifier(testAccountID), testBucketName, "", rules)
+	assert.ErrorIs(t, err, ErrMissingR2EventNotificationQueueID)
+
+	err = client.CreateR2EventNotificationRule(context.Background(), AccountIdentifier(testAccountID), testBucketName, testR2QueueID, nil)
+	assert.ErrorIs(t, err, ErrMissingR2EventNotificationRules)
+
+	err = client.CreateR2EventNotificationRule(context.Background(), AccountIdentifier(testAccountID), testBucketName, testR2QueueID, []R2EventNotificationRule{{Prefix: "images/"}})
+	assert.ErrorIs(t, err, ErrMissingR2EventNotificationActions)
+
+	err = client.CreateR2EventNotificationRule(context.Background(), AccountIdentifier(testAccountID), testBucketName, testR2QueueID, rules)
+	assert.NoError(t, err)
+}
+
+func TestR2_DeleteEventNotificationRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration/queues/%s", testAccountID, testBucketName, testR2QueueID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	err := client.DeleteR2EventNotificationRules(context.Background(), AccountIdentifier(testAccountID), testBucketName, testR2QueueID, nil)
+	assert.NoError(t, err)
+
+	err = client.DeleteR2EventNotificationRules(context.Background(), AccountIdentifier(testAccountID), testBucketName, testR2QueueID, []string{"rule-1"})
+	assert.NoError(t, err)
+
+	if assert.Len(t, bodies, 2) {
+		assert.Empty(t, bodies[0])
+		assert.JSONEq(t, `{"ruleIds": ["rule-1"]}`, bodies[1])
+	}
+}
@@ -93,7 +93,7 @@ func (api *API) ListDataLocalizationRegionalHostnames(ctx context.Context, rc *R
 	return result.Result, nil
 }
 
-// CreateDataLocalizationRegionalHostname lists all regional hostnames for a zone.
+// CreateDataLocalizationRegionalHostname pins a hostname to a region.
 //
 // API reference: https://developers.cloudflare.com/data-localization/regional-services/get-started/#configure-regional-services-via-api
 func (api *API) CreateDataLocalizationRegionalHostname(ctx context.Context, rc *ResourceContainer, params CreateDataLocalizationRegionalHostnameParams) (RegionalHostname, error) {
@@ -105,6 +105,10 @@ func (api *API) CreateDataLocalizationRegionalHostname(ctx context.Context, rc *
 		return RegionalHostname{}, ErrMissingZoneID
 	}
 
+	if params.Hostname == "" {
+		return RegionalHostname{}, ErrMissingHostname
+	}
+
 	uri := fmt.Sprintf("/zones/%s/addressing/regional_hostnames", rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
@@ -130,6 +134,10 @@ func (api *API) GetDataLocalizationRegionalHostname(ctx context.Context, rc *Res
 		return RegionalHostname{}, ErrMissingZoneID
 	}
 
+	if hostname == "" {
+		return RegionalHostname{}, ErrMissingHostname
+	}
+
 	uri := fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", rc.Identifier, hostname)
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
@@ -144,7 +152,7 @@ func (api *API) GetDataLocalizationRegionalHostname(ctx context.Context, rc *Res
 	return result.Result, nil
 }
 
-// UpdateDataLocalizationRegionalHostname returns the details of a specific regional hostname.
+// UpdateDataLocalizationRegionalHostname changes the region of a regional hostname.
 //
 // API reference: https://developers.cloudflare.com/data-localization/regional-services/get-started/#configure-regional-services-via-api
 func (api *API) UpdateDataLocalizationRegionalHostname(ctx context.Context, rc *ResourceContainer, params UpdateDataLocalizationRegionalHostnameParams) (RegionalHostname, error) {
@@ -156,6 +164,10 @@ func (api *API) UpdateDataLocalizationRegionalHostname(ctx context.Context, rc *
 		return RegionalHostname{}, ErrMissingZoneID
 	}
 
+	if params.Hostname == "" {
+		return RegionalHostname{}, ErrMissingHostname
+	}
+
 	uri := fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", rc.Identifier, params.Hostname)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
@@ -181,6 +193,10 @@ func (api *API) DeleteDataLocalizationRegionalHostname(ctx context.Context, rc *
 		return ErrMissingZoneID
 	}
 
+	if hostname == "" {
+		return ErrMissingHostname
+	}
+
 	uri := fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", rc.Identifier, hostname)
 
 	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
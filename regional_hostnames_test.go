@@ -217,3 +217,20 @@ func TestDeleteRegionalHostname(t *testing.T) {
 	err := client.DeleteDataLocalizationRegionalHostname(context.Background(), ZoneIdentifier(testZoneID), regionalHostname)
 	assert.NoError(t, err)
 }
+
+func TestRegionalHostname_MissingHostname(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CreateDataLocalizationRegionalHostname(context.Background(), ZoneIdentifier(testZoneID), CreateDataLocalizationRegionalHostnameParams{RegionKey: "eu"})
+	assert.ErrorIs(t, err, ErrMissingHostname)
+
+	_, err = client.GetDataLocalizationRegionalHostname(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingHostname)
+
+	_, err = client.UpdateDataLocalizationRegionalHostname(context.Background(), ZoneIdentifier(testZoneID), UpdateDataLocalizationRegionalHostnameParams{RegionKey: "eu"})
+	assert.ErrorIs(t, err, ErrMissingHostname)
+
+	err = client.DeleteDataLocalizationRegionalHostname(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingHostname)
+}
@@ -0,0 +1,62 @@
+package cloudflare
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// RequestRecorderFunc receives every request that would be sent to the API
+// along with its body. The response it returns is used in place of a real
+// one; returning nil responds with an empty successful API response.
+type RequestRecorderFunc func(req *http.Request) *http.Response
+
+// WithRequestRecorder stops the client from making network requests and
+// instead passes each fully formed request, including authentication and any
+// custom headers, to the recorder. This allows the exact method, URL and body
+// built by the library to be asserted on in tests.
+func WithRequestRecorder(recorder RequestRecorderFunc) Option {
+	return func(api *API) error {
+		api.requestRecorder = recorder
+		return nil
+	}
+}
+
+// send performs the HTTP request using the Doer or HTTP client, or hands it
+// to the request recorder.
+func (api *API) send(req *http.Request) (*http.Response, error) {
+	if api.requestRecorder == nil {
+		if api.doer != nil {
+			resp, err := api.doer.Do(req)
+			if resp != nil && resp.Request == nil {
+				resp.Request = req
+			}
+			return resp, err
+		}
+		return api.httpClient.Do(req)
+	}
+
+	if req.Body == nil {
+		req.Body = http.NoBody
+	}
+
+	resp := api.requestRecorder(req)
+	if resp == nil {
+		resp = &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"success":true,"errors":[],"messages":[],"result":null}`)),
+		}
+	}
+
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+	if resp.Body == nil {
+		resp.Body = http.NoBody
+	}
+	resp.Request = req
+
+	return resp, nil
+}
@@ -0,0 +1,53 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRequestRecorder(t *testing.T) {
+	var recorded []*http.Request
+	var bodies []string
+
+	api, err := NewWithAPIToken("my-token", UsingRateLimit(100000), WithRequestRecorder(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		recorded = append(recorded, req)
+		bodies = append(bodies, string(body))
+
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "www.example.com", "content": "192.0.2.1"}}`)),
+			}
+		}
+
+		return nil
+	}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.CreateDNSRecord(context.Background(), ZoneIdentifier(testZoneID), CreateDNSRecordParams{Type: "A", Name: "www.example.com", Content: "192.0.2.1"})
+	assert.NoError(t, err)
+
+	record, err := api.GetDNSRecord(context.Background(), ZoneIdentifier(testZoneID), "372e67954025e0ba6aaa6d586b9e0b59")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.0.2.1", record.Content)
+	}
+
+	if assert.Len(t, recorded, 2) {
+		assert.Equal(t, http.MethodPost, recorded[0].Method)
+		assert.Equal(t, "https://api.cloudflare.com/client/v4/zones/"+testZoneID+"/dns_records", recorded[0].URL.String())
+		assert.Equal(t, "Bearer my-token", recorded[0].Header.Get("Authorization"))
+		assert.JSONEq(t, `{"type": "A", "name": "www.example.com", "content": "192.0.2.1", "created_on": "0001-01-01T00:00:00Z", "modified_on": "0001-01-01T00:00:00Z"}`, bodies[0])
+
+		assert.Equal(t, http.MethodGet, recorded[1].Method)
+		assert.Equal(t, "/client/v4/zones/"+testZoneID+"/dns_records/372e67954025e0ba6aaa6d586b9e0b59", recorded[1].URL.Path)
+		assert.Empty(t, bodies[1])
+	}
+}
@@ -1,6 +1,10 @@
 package cloudflare
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+)
 
 // RouteLevel holds the "level" where the resource resides. Commonly used in
 // routing configurations or builders.
@@ -112,3 +116,46 @@ func AccountIdentifier(id string) *ResourceContainer {
 		Type:       AccountType,
 	}
 }
+
+type resourceContainerContextKey struct{}
+
+// WithResourceContainer returns a context carrying a resource container that
+// is used by methods supporting a default resource container when they're
+// called with a nil one. It takes precedence over the client wide default set
+// by `WithDefaultResourceContainer`.
+func WithResourceContainer(ctx context.Context, rc *ResourceContainer) context.Context {
+	return context.WithValue(ctx, resourceContainerContextKey{}, rc)
+}
+
+// WithDefaultResourceContainer sets the resource container used by methods
+// supporting a default resource container when they're called with a nil one.
+// An explicitly provided resource container is always used as is.
+//
+// This is currently supported by the DNS record methods.
+func WithDefaultResourceContainer(rc *ResourceContainer) Option {
+	return func(api *API) error {
+		if rc == nil {
+			return errors.New("default resource container cannot be nil")
+		}
+		api.defaultContainer = rc
+		return nil
+	}
+}
+
+// resolveResourceContainer returns rc when it is set, otherwise falling back
+// to the resource container from the context and then the client default.
+func (api *API) resolveResourceContainer(ctx context.Context, rc *ResourceContainer) (*ResourceContainer, error) {
+	if rc != nil {
+		return rc, nil
+	}
+
+	if rc, ok := ctx.Value(resourceContainerContextKey{}).(*ResourceContainer); ok && rc != nil {
+		return rc, nil
+	}
+
+	if api.defaultContainer != nil {
+		return api.defaultContainer, nil
+	}
+
+	return nil, ErrMissingResourceContainer
+}
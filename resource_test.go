@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,3 +65,30 @@ func TestResourcURLFragment(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveResourceContainer(t *testing.T) {
+	api, _ := New("deadbeef", "cloudflare@example.org")
+	_, err := api.resolveResourceContainer(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrMissingResourceContainer)
+
+	_, err = New("deadbeef", "cloudflare@example.org", WithDefaultResourceContainer(nil))
+	assert.Error(t, err)
+
+	api, _ = New("deadbeef", "cloudflare@example.org", WithDefaultResourceContainer(ZoneIdentifier("default")))
+
+	rc, err := api.resolveResourceContainer(context.Background(), nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneIdentifier("default"), rc)
+	}
+
+	ctx := WithResourceContainer(context.Background(), ZoneIdentifier("from-context"))
+	rc, err = api.resolveResourceContainer(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneIdentifier("from-context"), rc)
+	}
+
+	rc, err = api.resolveResourceContainer(ctx, ZoneIdentifier("explicit"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneIdentifier("explicit"), rc)
+	}
+}
@@ -12,7 +12,15 @@ import (
 )
 
 var (
-	ErrMissingRulesetPhase = errors.New("missing required phase")
+	ErrMissingRulesetPhase         = errors.New("missing required phase")
+	ErrInvalidDDoSSensitivityLevel = errors.New("sensitivity level must be default, medium, low or eoff")
+	ErrInvalidRulesetRuleAction    = errors.New("rule action is not supported in this phase")
+	ErrInvalidCompressionAlgorithm = errors.New("compression algorithm must be brotli, gzip, zstd, none or auto")
+	ErrMissingManagedRulesetID     = errors.New("missing required managed ruleset ID")
+	ErrMissingRulesetCacheKey      = errors.New("cache key rule requires cache key action parameters")
+	ErrRulesetCacheKeyRuleInUse    = errors.New("cache key rule also sets other cache settings")
+	ErrMissingRulesetName          = errors.New("missing required ruleset name")
+	ErrInvalidRulesetKind          = errors.New("ruleset kind must be custom, managed, root or zone")
 )
 
 const (
@@ -284,6 +292,15 @@ type RulesetRuleActionParametersTargetURL struct {
 	Expression string `json:"expression,omitempty"`
 }
 
+// Modes of the EdgeTTL and BrowserTTL action parameters of cache rules.
+const (
+	RulesetRuleActionParametersTTLModeRespectOrigin   = "respect_origin"
+	RulesetRuleActionParametersTTLModeOverrideOrigin  = "override_origin"
+	RulesetRuleActionParametersTTLModeBypassByDefault = "bypass_by_default"
+	// RulesetRuleActionParametersTTLModeBypass only applies to BrowserTTL.
+	RulesetRuleActionParametersTTLModeBypass = "bypass"
+)
+
 type RulesetRuleActionParametersEdgeTTL struct {
 	Mode          string                                     `json:"mode,omitempty"`
 	Default       *uint                                      `json:"default,omitempty"`
@@ -416,11 +433,29 @@ type RulesetRuleActionParametersURIQuery struct {
 // RulesetRuleActionParametersHTTPHeader is the definition for define action
 // parameters that involve HTTP headers.
 type RulesetRuleActionParametersHTTPHeader struct {
-	Operation  string `json:"operation,omitempty"`
+	Operation string `json:"operation,omitempty"`
+
+	// Value is a static header value. Expression is evaluated per request
+	// instead, e.g. to echo a request field. Only one of them is set and
+	// neither is used by the `remove` operation.
 	Value      string `json:"value,omitempty"`
 	Expression string `json:"expression,omitempty"`
 }
 
+// SetResponseHeaderExpression returns the action parameters of a `rewrite`
+// rule in the `http_response_headers_transform` phase that sets the response
+// header to the result of the expression.
+func SetResponseHeaderExpression(name, expression string) *RulesetRuleActionParameters {
+	return &RulesetRuleActionParameters{
+		Headers: map[string]RulesetRuleActionParametersHTTPHeader{
+			name: {
+				Operation:  string(RulesetRuleActionParametersHTTPHeaderOperationSet),
+				Expression: expression,
+			},
+		},
+	}
+}
+
 type RulesetRuleActionParametersOverrides struct {
 	Enabled          *bool                                   `json:"enabled,omitempty"`
 	Action           string                                  `json:"action,omitempty"`
@@ -468,6 +503,16 @@ type RulesetRuleActionParametersCompressionAlgorithm struct {
 	Name string `json:"name"`
 }
 
+// Names of the compression algorithms of the compress_response action.
+// `none` disables compression and `auto` uses the default algorithms.
+const (
+	RulesetRuleActionParametersCompressionAlgorithmBrotli = "brotli"
+	RulesetRuleActionParametersCompressionAlgorithmGzip   = "gzip"
+	RulesetRuleActionParametersCompressionAlgorithmZstd   = "zstd"
+	RulesetRuleActionParametersCompressionAlgorithmNone   = "none"
+	RulesetRuleActionParametersCompressionAlgorithmAuto   = "auto"
+)
+
 type Polish int
 
 const (
@@ -649,7 +694,7 @@ func (p SSL) IntoRef() *SSL {
 type RulesetRule struct {
 	ID                     string                             `json:"id,omitempty"`
 	Version                *string                            `json:"version,omitempty"`
-	Action                 string                             `json:"action"`
+	Action                 RulesetRuleAction                  `json:"action"`
 	ActionParameters       *RulesetRuleActionParameters       `json:"action_parameters,omitempty"`
 	Expression             string                             `json:"expression"`
 	Description            string                             `json:"description,omitempty"`
@@ -717,7 +762,11 @@ type UpdateRulesetResponse struct {
 	Result Ruleset `json:"result"`
 }
 
-type ListRulesetsParams struct{}
+// ListRulesetsParams contains the optional filters for listing rulesets.
+// Filtering is performed locally as the API does not support it.
+type ListRulesetsParams struct {
+	Phase string `json:"-" url:"-"`
+}
 
 type CreateRulesetParams struct {
 	Name        string        `json:"name,omitempty"`
@@ -757,7 +806,18 @@ func (api *API) ListRulesets(ctx context.Context, rc *ResourceContainer, params
 		return []Ruleset{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 
-	return result.Result, nil
+	if params.Phase == "" {
+		return result.Result, nil
+	}
+
+	rulesets := []Ruleset{}
+	for _, r := range result.Result {
+		if r.Phase == params.Phase {
+			rulesets = append(rulesets, r)
+		}
+	}
+
+	return rulesets, nil
 }
 
 // GetRuleset fetches a single ruleset.
@@ -779,11 +839,31 @@ func (api *API) GetRuleset(ctx context.Context, rc *ResourceContainer, rulesetID
 	return result.Result, nil
 }
 
-// CreateRuleset creates a new ruleset.
+// CreateRuleset creates a new ruleset and returns it with its ID and version.
+// Rulesets of the `custom` kind can be run from the entrypoint of their phase
+// with an `execute` rule referencing the returned ID.
 //
 // API reference: https://developers.cloudflare.com/api/operations/createAccountRuleset
 // API reference: https://developers.cloudflare.com/api/operations/createZoneRuleset
 func (api *API) CreateRuleset(ctx context.Context, rc *ResourceContainer, params CreateRulesetParams) (Ruleset, error) {
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingResourceIdentifier
+	}
+
+	if params.Name == "" {
+		return Ruleset{}, ErrMissingRulesetName
+	}
+
+	if params.Phase == "" {
+		return Ruleset{}, ErrMissingRulesetPhase
+	}
+
+	switch RulesetKind(params.Kind) {
+	case RulesetKindCustom, RulesetKindManaged, RulesetKindRoot, RulesetKindZone:
+	default:
+		return Ruleset{}, ErrInvalidRulesetKind
+	}
+
 	uri := fmt.Sprintf("/%s/%s/rulesets", rc.Level, rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -847,6 +927,10 @@ func (api *API) UpdateRuleset(ctx context.Context, rc *ResourceContainer, params
 // API reference: https://developers.cloudflare.com/api/operations/getAccountEntrypointRuleset
 // API reference: https://developers.cloudflare.com/api/operations/getZoneEntrypointRuleset
 func (api *API) GetEntrypointRuleset(ctx context.Context, rc *ResourceContainer, phase string) (Ruleset, error) {
+	if phase == "" {
+		return Ruleset{}, ErrMissingRulesetPhase
+	}
+
 	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint", rc.Level, rc.Identifier, phase)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -884,3 +968,469 @@ func (api *API) UpdateEntrypointRuleset(ctx context.Context, rc *ResourceContain
 
 	return result.Result, nil
 }
+
+// DDoSL7ManagedRulesetID is the ID of the HTTP DDoS Attack Protection managed
+// ruleset.
+const DDoSL7ManagedRulesetID = "4d21379b4f9f4bb088e0729962c8b3cf"
+
+// Sensitivity levels of the rules of the DDoS managed rulesets.
+const (
+	DDoSSensitivityLevelDefault = "default"
+	DDoSSensitivityLevelMedium  = "medium"
+	DDoSSensitivityLevelLow     = "low"
+	// DDoSSensitivityLevelEssentiallyOff only mitigates the largest attacks.
+	DDoSSensitivityLevelEssentiallyOff = "eoff"
+)
+
+// UpdateDDoSL7Overrides deploys the HTTP DDoS Attack Protection managed
+// ruleset with the given overrides to the `ddos_l7` entry point ruleset of an
+// account or zone, replacing any existing overrides. Overrides can change the
+// action and sensitivity level of the whole ruleset, of categories or of
+// individual rules.
+//
+// API reference: https://developers.cloudflare.com/ddos-protection/managed-rulesets/http/http-overrides/configure-api/
+func (api *API) UpdateDDoSL7Overrides(ctx context.Context, rc *ResourceContainer, overrides RulesetRuleActionParametersOverrides) (Ruleset, error) {
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingResourceIdentifier
+	}
+
+	levels := []string{overrides.SensitivityLevel}
+	for _, rule := range overrides.Rules {
+		levels = append(levels, rule.SensitivityLevel)
+	}
+
+	for _, level := range levels {
+		switch level {
+		case "", DDoSSensitivityLevelDefault, DDoSSensitivityLevelMedium, DDoSSensitivityLevelLow, DDoSSensitivityLevelEssentiallyOff:
+		default:
+			return Ruleset{}, ErrInvalidDDoSSensitivityLevel
+		}
+	}
+
+	return api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase: string(RulesetPhaseDDoSL7),
+		Rules: []RulesetRule{{
+			Action:      RulesetRuleActionExecute,
+			Expression:  "true",
+			Description: "Execute HTTP DDoS Attack Protection managed ruleset",
+			ActionParameters: &RulesetRuleActionParameters{
+				ID:        DDoSL7ManagedRulesetID,
+				Overrides: &overrides,
+			},
+		}},
+	})
+}
+
+// IDs of the managed rulesets deployed in the `http_request_firewall_managed`
+// phase.
+const (
+	CloudflareManagedRulesetID       = "efb7b8c949ac4650a09736fc376e9aee"
+	OWASPCoreRulesetID               = "4814384a9e5d4991b9815dcfc25d2f1f"
+	ExposedCredentialsCheckRulesetID = "c2e184081120413c86c3ab7e14069605"
+)
+
+// DeployManagedRulesetParams describes the execute rule that deploys a
+// managed ruleset.
+type DeployManagedRulesetParams struct {
+	// ID is the ID of the managed ruleset, e.g. CloudflareManagedRulesetID.
+	ID string
+
+	// Expression limits the requests the managed ruleset is executed for.
+	// Defaults to all requests.
+	Expression  string
+	Description string
+	Enabled     *bool
+	Overrides   *RulesetRuleActionParametersOverrides
+
+	// MatchedData enables payload logging, encrypted with the public key.
+	MatchedData *RulesetRuleActionParametersMatchedData
+}
+
+// DeployManagedRuleset adds a rule executing a managed ruleset to the
+// `http_request_firewall_managed` entry point ruleset of an account or zone.
+// An existing rule executing the same managed ruleset is replaced, keeping
+// its position, and other rules are left untouched.
+//
+// API reference: https://developers.cloudflare.com/waf/managed-rules/deploy-api/
+func (api *API) DeployManagedRuleset(ctx context.Context, rc *ResourceContainer, params DeployManagedRulesetParams) (Ruleset, error) {
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingResourceIdentifier
+	}
+
+	if params.ID == "" {
+		return Ruleset{}, ErrMissingManagedRulesetID
+	}
+
+	if params.Expression == "" {
+		params.Expression = "true"
+	}
+
+	rule := RulesetRule{
+		Action:      RulesetRuleActionExecute,
+		Expression:  params.Expression,
+		Description: params.Description,
+		Enabled:     params.Enabled,
+		ActionParameters: &RulesetRuleActionParameters{
+			ID:          params.ID,
+			Overrides:   params.Overrides,
+			MatchedData: params.MatchedData,
+		},
+	}
+
+	var rules []RulesetRule
+	entrypoint, err := api.GetEntrypointRuleset(ctx, rc, string(RulesetPhaseHTTPRequestFirewallManaged))
+	if err != nil {
+		var notFoundErr *NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return Ruleset{}, err
+		}
+	} else {
+		rules = entrypoint.Rules
+	}
+
+	deployed := false
+	for i, r := range rules {
+		if r.Action == RulesetRuleActionExecute && r.ActionParameters != nil && r.ActionParameters.ID == params.ID {
+			rule.ID = r.ID
+			rules[i] = rule
+			deployed = true
+			break
+		}
+	}
+
+	if !deployed {
+		rules = append(rules, rule)
+	}
+
+	return api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase:       string(RulesetPhaseHTTPRequestFirewallManaged),
+		Description: entrypoint.Description,
+		Rules:       rules,
+	})
+}
+
+// UpdateCacheRules replaces all of the Cache Rules of a zone, the
+// `set_cache_settings` rules of the `http_request_cache_settings` phase.
+// Rules control whether requests are eligible for caching (Cache), the edge
+// and browser TTLs (EdgeTTL, BrowserTTL), ServeStale, RespectStrongETags and
+// the cache key (CacheKey).
+//
+// API reference: https://developers.cloudflare.com/cache/how-to/cache-rules/create-api/
+func (api *API) UpdateCacheRules(ctx context.Context, rc *ResourceContainer, rules []RulesetRule) (Ruleset, error) {
+	return api.updatePhaseRules(ctx, rc, RulesetPhaseHTTPRequestCacheSettings, RulesetRuleActionSetCacheSettings, rules)
+}
+
+// UpdateCacheKeyRules replaces the rules of the `http_request_cache_settings`
+// phase of a zone that customize the cache key, leaving the other cache
+// rules in place. Each rule sets ActionParameters.CacheKey, whose CustomKey
+// selects the query string, header, cookie, user and host components of the
+// cache key.
+//
+// Rules are matched with the existing rules of the phase by Ref, or by
+// Expression when no Ref is set, and updated in place so that the order in
+// which rules are evaluated is kept. Existing rules that also set other cache
+// settings only have their CacheKey replaced. Rules without a match are
+// appended to the phase and unmatched rules that only customize the cache key
+// are removed. An unmatched rule that sets both the cache key and other cache
+// settings can't be removed without losing those settings, so
+// ErrRulesetCacheKeyRuleInUse is returned instead.
+//
+// API reference: https://developers.cloudflare.com/cache/how-to/cache-rules/create-api/
+func (api *API) UpdateCacheKeyRules(ctx context.Context, rc *ResourceContainer, rules []RulesetRule) (Ruleset, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Ruleset{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingZoneID
+	}
+
+	for _, rule := range rules {
+		if rule.ActionParameters == nil || rule.ActionParameters.CacheKey == nil {
+			return Ruleset{}, ErrMissingRulesetCacheKey
+		}
+	}
+
+	entrypoint, err := api.GetEntrypointRuleset(ctx, rc, string(RulesetPhaseHTTPRequestCacheSettings))
+	if err != nil {
+		var notFoundErr *NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return Ruleset{}, err
+		}
+	}
+
+	matched := make([]bool, len(rules))
+	phaseRules := make([]RulesetRule, 0, len(entrypoint.Rules)+len(rules))
+	for _, live := range entrypoint.Rules {
+		i := cacheKeyRuleIndex(rules, matched, live)
+		onlyCacheKey, err := isCacheKeyOnlyRule(live)
+		if err != nil {
+			return Ruleset{}, err
+		}
+
+		switch {
+		case i >= 0 && onlyCacheKey:
+			matched[i] = true
+			rule := rules[i]
+			rule.ID = live.ID
+			phaseRules = append(phaseRules, rule)
+		case i >= 0:
+			matched[i] = true
+			params := *live.ActionParameters
+			params.CacheKey = rules[i].ActionParameters.CacheKey
+			live.ActionParameters = &params
+			phaseRules = append(phaseRules, live)
+		case onlyCacheKey:
+			// Replaced by the given rules.
+		case live.ActionParameters != nil && live.ActionParameters.CacheKey != nil:
+			return Ruleset{}, fmt.Errorf("%w: %s", ErrRulesetCacheKeyRuleInUse, live.ID)
+		default:
+			phaseRules = append(phaseRules, live)
+		}
+	}
+
+	for i, rule := range rules {
+		if !matched[i] {
+			phaseRules = append(phaseRules, rule)
+		}
+	}
+
+	return api.updatePhaseRules(ctx, rc, RulesetPhaseHTTPRequestCacheSettings, RulesetRuleActionSetCacheSettings, phaseRules)
+}
+
+// cacheKeyRuleIndex returns the index of the first unmatched rule that
+// corresponds to the live rule, or -1 when there is none.
+func cacheKeyRuleIndex(rules []RulesetRule, matched []bool, live RulesetRule) int {
+	for i, rule := range rules {
+		if matched[i] {
+			continue
+		}
+
+		if rule.Ref != "" {
+			if rule.Ref == live.Ref {
+				return i
+			}
+			continue
+		}
+
+		if rule.Expression == live.Expression {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// isCacheKeyOnlyRule reports whether the only action parameter of a rule is
+// the cache key.
+func isCacheKeyOnlyRule(rule RulesetRule) (bool, error) {
+	if rule.ActionParameters == nil || rule.ActionParameters.CacheKey == nil {
+		return false, nil
+	}
+
+	params := *rule.ActionParameters
+	params.CacheKey = nil
+	b, err := json.Marshal(params)
+	if err != nil {
+		return false, fmt.Errorf("error marshalling params to JSON: %w", err)
+	}
+
+	return string(b) == "{}", nil
+}
+
+// UpdateOriginRules replaces the Origin Rules of a zone, the `route` rules of
+// the `http_request_origin` phase. Rules can override the Host header, the
+// resolved origin host and port, and the SNI sent to the origin using the
+// HostHeader, Origin and SNI action parameters.
+//
+// API reference: https://developers.cloudflare.com/rules/origin-rules/create-api/
+func (api *API) UpdateOriginRules(ctx context.Context, rc *ResourceContainer, rules []RulesetRule) (Ruleset, error) {
+	return api.updatePhaseRules(ctx, rc, RulesetPhaseHTTPRequestOrigin, RulesetRuleActionRoute, rules)
+}
+
+// UpdateConfigRules replaces the Configuration Rules of a zone, the
+// `set_config` rules of the `http_config_settings` phase. Rules override zone
+// settings such as SSL, SecurityLevel, Polish, DisableApps, Mirage and
+// AutomaticHTTPSRewrites for the requests they match. The cache level isn't
+// a configuration setting; use Cache Rules to change caching instead.
+//
+// API reference: https://developers.cloudflare.com/rules/configuration-rules/create-api/
+func (api *API) UpdateConfigRules(ctx context.Context, rc *ResourceContainer, rules []RulesetRule) (Ruleset, error) {
+	return api.updatePhaseRules(ctx, rc, RulesetPhaseHTTPConfigSettings, RulesetRuleActionSetConfig, rules)
+}
+
+// UpdateCompressionRules replaces the Compression Rules of a zone, the
+// `compress_response` rules of the `http_response_compression` phase. The
+// Algorithms action parameter lists the algorithms to use in order of
+// preference.
+//
+// API reference: https://developers.cloudflare.com/rules/compression-rules/create-api/
+func (api *API) UpdateCompressionRules(ctx context.Context, rc *ResourceContainer, rules []RulesetRule) (Ruleset, error) {
+	for _, rule := range rules {
+		if rule.ActionParameters == nil {
+			continue
+		}
+
+		for _, algorithm := range rule.ActionParameters.Algorithms {
+			switch algorithm.Name {
+			case RulesetRuleActionParametersCompressionAlgorithmBrotli,
+				RulesetRuleActionParametersCompressionAlgorithmGzip,
+				RulesetRuleActionParametersCompressionAlgorithmZstd,
+				RulesetRuleActionParametersCompressionAlgorithmNone,
+				RulesetRuleActionParametersCompressionAlgorithmAuto:
+			default:
+				return Ruleset{}, fmt.Errorf("%w: %q", ErrInvalidCompressionAlgorithm, algorithm.Name)
+			}
+		}
+	}
+
+	return api.updatePhaseRules(ctx, rc, RulesetPhaseHTTPResponseCompression, RulesetRuleActionCompressResponse, rules)
+}
+
+// updatePhaseRules replaces the rules of the zone entry point ruleset of a
+// phase where every rule has to use the same action. Rules without an action
+// are given that action.
+func (api *API) updatePhaseRules(ctx context.Context, rc *ResourceContainer, phase RulesetPhase, action RulesetRuleAction, rules []RulesetRule) (Ruleset, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Ruleset{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingZoneID
+	}
+
+	phaseRules := make([]RulesetRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Action == "" {
+			rule.Action = action
+		}
+
+		if rule.Action != action {
+			return Ruleset{}, fmt.Errorf("%w: %s in %s", ErrInvalidRulesetRuleAction, rule.Action, phase)
+		}
+
+		phaseRules = append(phaseRules, rule)
+	}
+
+	return api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase: string(phase),
+		Rules: phaseRules,
+	})
+}
+
+// RulesetDiff describes how the live rules of a ruleset differ from the
+// desired ones.
+type RulesetDiff struct {
+	// Added are desired rules that have no live counterpart.
+	Added []RulesetRule
+	// Removed are live rules that aren't desired.
+	Removed []RulesetRule
+	// Changed are rules present in both whose configuration differs.
+	Changed []RulesetRuleChange
+	// Reordered is set when the rules present in both are evaluated in a
+	// different order.
+	Reordered bool
+}
+
+// RulesetRuleChange is a rule whose live configuration differs from the
+// desired one.
+type RulesetRuleChange struct {
+	Desired RulesetRule
+	Live    RulesetRule
+}
+
+// Empty reports whether the live rules already match the desired ones.
+func (d RulesetDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 && !d.Reordered
+}
+
+// DiffRuleset compares the desired rules of a ruleset with the live ones, for
+// example from GetRuleset. A desired rule with a Ref is matched to the live
+// rule with the same ref, any other rule to a live rule with the same action
+// and expression. Fields set by the API, such as the ID, version and last
+// update, are ignored and a rule without Enabled is considered enabled.
+func DiffRuleset(desired, live []RulesetRule) RulesetDiff {
+	var diff RulesetDiff
+
+	matched := make([]bool, len(live))
+	var order []int
+	for _, want := range desired {
+		i := matchRulesetRule(want, live, matched)
+		if i < 0 {
+			diff.Added = append(diff.Added, want)
+			continue
+		}
+
+		matched[i] = true
+		order = append(order, i)
+		if !rulesetRulesEqual(want, live[i]) {
+			diff.Changed = append(diff.Changed, RulesetRuleChange{Desired: want, Live: live[i]})
+		}
+	}
+
+	for i, rule := range live {
+		if !matched[i] {
+			diff.Removed = append(diff.Removed, rule)
+		}
+	}
+
+	for i := 1; i < len(order); i++ {
+		if order[i] < order[i-1] {
+			diff.Reordered = true
+			break
+		}
+	}
+
+	return diff
+}
+
+// matchRulesetRule returns the index of the first unmatched live rule with the
+// same identity as rule, or -1.
+func matchRulesetRule(rule RulesetRule, live []RulesetRule, matched []bool) int {
+	for i, l := range live {
+		if matched[i] {
+			continue
+		}
+
+		if rule.Ref != "" {
+			if l.Ref == rule.Ref {
+				return i
+			}
+			continue
+		}
+
+		if l.Action == rule.Action && l.Expression == rule.Expression {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// rulesetRulesEqual reports whether two rules are configured the same,
+// ignoring the fields managed by the API.
+func rulesetRulesEqual(desired, live RulesetRule) bool {
+	normalize := func(rule RulesetRule) ([]byte, error) {
+		rule.ID = ""
+		rule.Version = nil
+		rule.LastUpdated = nil
+		if desired.Ref == "" {
+			rule.Ref = ""
+		}
+		if rule.Enabled == nil {
+			rule.Enabled = BoolPtr(true)
+		}
+		return json.Marshal(rule)
+	}
+
+	a, err := normalize(desired)
+	if err != nil {
+		return false
+	}
+	b, err := normalize(live)
+	if err != nil {
+		return false
+	}
+
+	return string(a) == string(b)
+}
@@ -3,10 +3,12 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,6 +65,59 @@ func TestListRulesets(t *testing.T) {
 	}
 }
 
+func TestListRulesets_PhaseFilter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "result": [
+        {
+          "id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+          "name": "my example ruleset",
+          "kind": "zone",
+          "version": "1",
+          "phase": "http_request_firewall_custom"
+        },
+        {
+          "id": "70339d97bdb34195bbf054b1ebe81f76",
+          "name": "redirects",
+          "kind": "zone",
+          "version": "3",
+          "phase": "http_request_dynamic_redirect"
+        }
+      ],
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets", handler)
+
+	want := []Ruleset{
+		{
+			ID:      "70339d97bdb34195bbf054b1ebe81f76",
+			Name:    "redirects",
+			Kind:    "zone",
+			Version: StringPtr("3"),
+			Phase:   string(RulesetPhaseHTTPRequestDynamicRedirect),
+		},
+	}
+
+	actual, err := client.ListRulesets(context.Background(), ZoneIdentifier(testZoneID), ListRulesetsParams{Phase: string(RulesetPhaseHTTPRequestDynamicRedirect)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	actual, err = client.ListRulesets(context.Background(), ZoneIdentifier(testZoneID), ListRulesetsParams{Phase: string(RulesetPhaseHTTPRatelimit)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Ruleset{}, actual)
+	}
+}
+
 func TestGetRuleset_MagicTransit(t *testing.T) {
 	setup()
 	defer teardown()
@@ -162,7 +217,7 @@ func TestGetRuleset_WAF(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "78723a9e0c7c4c6dbec5684cb766231d",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionRewrite),
+		Action:  RulesetRuleActionRewrite,
 		ActionParameters: &RulesetRuleActionParameters{
 			URI: &RulesetRuleActionParametersURI{
 				Path: &RulesetRuleActionParametersURIPath{
@@ -273,7 +328,7 @@ func TestGetRuleset_SetCacheSettings(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "78723a9e0c7c4c6dbec5684cb766231d",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionSetCacheSettings),
+		Action:  RulesetRuleActionSetCacheSettings,
 		ActionParameters: &RulesetRuleActionParameters{
 			Cache: BoolPtr(true),
 			EdgeTTL: &RulesetRuleActionParametersEdgeTTL{
@@ -428,7 +483,7 @@ func TestGetRuleset_SetConfig(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "78723a9e0c7c4c6dbec5684cb766231d",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionSetConfig),
+		Action:  RulesetRuleActionSetConfig,
 		ActionParameters: &RulesetRuleActionParameters{
 			AutomaticHTTPSRewrites: BoolPtr(true),
 			AutoMinify: &RulesetRuleActionParametersAutoMinify{
@@ -530,7 +585,7 @@ func TestGetRuleset_RedirectFromValue(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "78723a9e0c7c4c6dbec5684cb766231d",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionRedirect),
+		Action:  RulesetRuleActionRedirect,
 		ActionParameters: &RulesetRuleActionParameters{
 			FromValue: &RulesetRuleActionParametersFromValue{
 				StatusCode: 301,
@@ -613,7 +668,7 @@ func TestGetRuleset_CompressResponse(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "78723a9e0c7c4c6dbec5684cb766231d",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionCompressResponse),
+		Action:  RulesetRuleActionCompressResponse,
 		ActionParameters: &RulesetRuleActionParameters{
 			Algorithms: []RulesetRuleActionParametersCompressionAlgorithm{
 				{Name: "brotli"},
@@ -694,7 +749,7 @@ func TestCreateRuleset(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "62449e2e0de149619edb35e59c10d801",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionSkip),
+		Action:  RulesetRuleActionSkip,
 		ActionParameters: &RulesetRuleActionParameters{
 			Ruleset: "current",
 		},
@@ -735,6 +790,35 @@ func TestCreateRuleset(t *testing.T) {
 	}
 }
 
+func TestCreateRuleset_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := CreateRulesetParams{
+		Name:  "reusable custom ruleset",
+		Kind:  string(RulesetKindCustom),
+		Phase: string(RulesetPhaseHTTPRequestFirewallCustom),
+	}
+
+	_, err := client.CreateRuleset(context.Background(), AccountIdentifier(""), params)
+	assert.ErrorIs(t, err, ErrMissingResourceIdentifier)
+
+	noName := params
+	noName.Name = ""
+	_, err = client.CreateRuleset(context.Background(), AccountIdentifier(testAccountID), noName)
+	assert.ErrorIs(t, err, ErrMissingRulesetName)
+
+	noPhase := params
+	noPhase.Phase = ""
+	_, err = client.CreateRuleset(context.Background(), AccountIdentifier(testAccountID), noPhase)
+	assert.ErrorIs(t, err, ErrMissingRulesetPhase)
+
+	badKind := params
+	badKind.Kind = "entrypoint"
+	_, err = client.CreateRuleset(context.Background(), AccountIdentifier(testAccountID), badKind)
+	assert.ErrorIs(t, err, ErrInvalidRulesetKind)
+}
+
 func TestDeleteRuleset(t *testing.T) {
 	setup()
 	defer teardown()
@@ -814,7 +898,7 @@ func TestUpdateRuleset(t *testing.T) {
 	rules := []RulesetRule{{
 		ID:      "62449e2e0de149619edb35e59c10d801",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionSkip),
+		Action:  RulesetRuleActionSkip,
 		ActionParameters: &RulesetRuleActionParameters{
 			Ruleset: "current",
 		},
@@ -826,7 +910,7 @@ func TestUpdateRuleset(t *testing.T) {
 	}, {
 		ID:      "62449e2e0de149619edb35e59c10d802",
 		Version: StringPtr("1"),
-		Action:  string(RulesetRuleActionSkip),
+		Action:  RulesetRuleActionSkip,
 		ActionParameters: &RulesetRuleActionParameters{
 			Ruleset: "current",
 		},
@@ -864,3 +948,673 @@ func TestUpdateRuleset(t *testing.T) {
 		assert.Equal(t, want, accountActual)
 	}
 }
+
+func TestUpdateDDoSL7Overrides(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/ddos_l7/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rules": [{
+				"action": "execute",
+				"expression": "true",
+				"description": "Execute HTTP DDoS Attack Protection managed ruleset",
+				"action_parameters": {
+					"id": "4d21379b4f9f4bb088e0729962c8b3cf",
+					"overrides": {
+						"sensitivity_level": "medium",
+						"categories": [{"category": "botnets", "action": "log"}],
+						"rules": [{"id": "fdfdac75430c4c47a959592f0aa5e68a", "action": "block", "sensitivity_level": "low"}]
+					}
+				}
+			}]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "root",
+				"version": "1",
+				"phase": "ddos_l7",
+				"rules": []
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	overrides := RulesetRuleActionParametersOverrides{
+		SensitivityLevel: DDoSSensitivityLevelMedium,
+		Categories:       []RulesetRuleActionParametersCategories{{Category: "botnets", Action: "log"}},
+		Rules: []RulesetRuleActionParametersRules{{
+			ID:               "fdfdac75430c4c47a959592f0aa5e68a",
+			Action:           "block",
+			SensitivityLevel: DDoSSensitivityLevelLow,
+		}},
+	}
+
+	_, err := client.UpdateDDoSL7Overrides(context.Background(), AccountIdentifier(testAccountID), RulesetRuleActionParametersOverrides{SensitivityLevel: "high"})
+	assert.ErrorIs(t, err, ErrInvalidDDoSSensitivityLevel)
+
+	ruleset, err := client.UpdateDDoSL7Overrides(context.Background(), AccountIdentifier(testAccountID), overrides)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(RulesetPhaseDDoSL7), ruleset.Phase)
+	}
+}
+
+func TestMagicTransitEntrypointRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"rules": [
+					{"action": "skip", "expression": "ip.src in {192.0.2.0/24}", "description": "allow office"},
+					{"action": "block", "expression": "tcp.dstport in {22}", "description": "block ssh"}
+				]
+			}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "root",
+				"version": "1",
+				"phase": "magic_transit",
+				"rules": [
+					{"id": "1", "action": "skip", "expression": "ip.src in {192.0.2.0/24}", "description": "allow office"},
+					{"id": "2", "action": "block", "expression": "tcp.dstport in {22}", "description": "block ssh"}
+				]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	_, err := client.GetEntrypointRuleset(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingRulesetPhase)
+
+	ruleset, err := client.GetEntrypointRuleset(context.Background(), AccountIdentifier(testAccountID), string(RulesetPhaseMagicTransit))
+	if assert.NoError(t, err) && assert.Len(t, ruleset.Rules, 2) {
+		assert.Equal(t, RulesetRuleActionSkip, ruleset.Rules[0].Action)
+	}
+
+	// Rules are evaluated in order, so their position is their priority.
+	_, err = client.UpdateEntrypointRuleset(context.Background(), AccountIdentifier(testAccountID), UpdateEntrypointRulesetParams{
+		Phase: string(RulesetPhaseMagicTransit),
+		Rules: []RulesetRule{
+			{Action: RulesetRuleActionSkip, Expression: "ip.src in {192.0.2.0/24}", Description: "allow office"},
+			{Action: RulesetRuleActionBlock, Expression: "tcp.dstport in {22}", Description: "block ssh"},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateOriginRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_origin/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rules": [{
+				"action": "route",
+				"expression": "starts_with(http.request.uri.path, \"/api/\")",
+				"action_parameters": {
+					"host_header": "api.internal.example.com",
+					"origin": {"host": "api.internal.example.com", "port": 8443},
+					"sni": {"value": "api.internal.example.com"}
+				}
+			}]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "zone",
+				"phase": "http_request_origin",
+				"rules": [{
+					"id": "62449e2e0de149619edb35e59c10d801",
+					"action": "route",
+					"expression": "starts_with(http.request.uri.path, \"/api/\")",
+					"action_parameters": {
+						"host_header": "api.internal.example.com",
+						"origin": {"host": "api.internal.example.com", "port": 8443},
+						"sni": {"value": "api.internal.example.com"}
+					}
+				}]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	rules := []RulesetRule{{
+		Expression: `starts_with(http.request.uri.path, "/api/")`,
+		ActionParameters: &RulesetRuleActionParameters{
+			HostHeader: "api.internal.example.com",
+			Origin:     &RulesetRuleActionParametersOrigin{Host: "api.internal.example.com", Port: 8443},
+			SNI:        &RulesetRuleActionParametersSni{Value: "api.internal.example.com"},
+		},
+	}}
+
+	_, err := client.UpdateOriginRules(context.Background(), AccountIdentifier(testAccountID), rules)
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	_, err = client.UpdateOriginRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{Action: RulesetRuleActionBlock, Expression: "true"}})
+	assert.ErrorIs(t, err, ErrInvalidRulesetRuleAction)
+
+	ruleset, err := client.UpdateOriginRules(context.Background(), ZoneIdentifier(testZoneID), rules)
+	if assert.NoError(t, err) && assert.Len(t, ruleset.Rules, 1) {
+		assert.Equal(t, RulesetRuleActionRoute, ruleset.Rules[0].Action)
+		assert.Equal(t, uint16(8443), ruleset.Rules[0].ActionParameters.Origin.Port)
+	}
+}
+
+func TestUpdateConfigRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_config_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rules": [{
+				"action": "set_config",
+				"expression": "starts_with(http.request.uri.path, \"/api/\")",
+				"action_parameters": {
+					"ssl": "full",
+					"security_level": "essentially_off",
+					"polish": "off",
+					"disable_apps": true,
+					"mirage": false,
+					"automatic_https_rewrites": false
+				}
+			}]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "zone",
+				"phase": "http_config_settings",
+				"rules": [{
+					"id": "62449e2e0de149619edb35e59c10d801",
+					"action": "set_config",
+					"expression": "starts_with(http.request.uri.path, \"/api/\")",
+					"action_parameters": {
+						"ssl": "full",
+						"security_level": "essentially_off",
+						"polish": "off",
+						"disable_apps": true,
+						"mirage": false,
+						"automatic_https_rewrites": false
+					}
+				}]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	ruleset, err := client.UpdateConfigRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression: `starts_with(http.request.uri.path, "/api/")`,
+		ActionParameters: &RulesetRuleActionParameters{
+			SSL:                    SSLFull.IntoRef(),
+			SecurityLevel:          SecurityLevelEssentiallyOff.IntoRef(),
+			Polish:                 PolishOff.IntoRef(),
+			DisableApps:            BoolPtr(true),
+			Mirage:                 BoolPtr(false),
+			AutomaticHTTPSRewrites: BoolPtr(false),
+		},
+	}})
+	if assert.NoError(t, err) && assert.Len(t, ruleset.Rules, 1) {
+		assert.Equal(t, RulesetRuleActionSetConfig, ruleset.Rules[0].Action)
+		assert.Equal(t, SSLFull.IntoRef(), ruleset.Rules[0].ActionParameters.SSL)
+	}
+
+	_, err = client.UpdateConfigRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{Action: RulesetRuleActionRoute, Expression: "true"}})
+	assert.ErrorIs(t, err, ErrInvalidRulesetRuleAction)
+}
+
+func TestUpdateCompressionRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_response_compression/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rules": [
+				{
+					"action": "compress_response",
+					"expression": "http.request.uri.path.extension in {\"jpg\" \"png\"}",
+					"action_parameters": {"algorithms": [{"name": "none"}]}
+				},
+				{
+					"action": "compress_response",
+					"expression": "true",
+					"action_parameters": {"algorithms": [{"name": "brotli"}, {"name": "gzip"}]}
+				}
+			]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "zone",
+				"phase": "http_response_compression",
+				"rules": []
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	_, err := client.UpdateCompressionRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression: "true",
+		ActionParameters: &RulesetRuleActionParameters{
+			Algorithms: []RulesetRuleActionParametersCompressionAlgorithm{{Name: "deflate"}},
+		},
+	}})
+	assert.ErrorIs(t, err, ErrInvalidCompressionAlgorithm)
+
+	_, err = client.UpdateCompressionRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{
+		{
+			Expression: `http.request.uri.path.extension in {"jpg" "png"}`,
+			ActionParameters: &RulesetRuleActionParameters{
+				Algorithms: []RulesetRuleActionParametersCompressionAlgorithm{
+					{Name: RulesetRuleActionParametersCompressionAlgorithmNone},
+				},
+			},
+		},
+		{
+			Expression: "true",
+			ActionParameters: &RulesetRuleActionParameters{
+				Algorithms: []RulesetRuleActionParametersCompressionAlgorithm{
+					{Name: RulesetRuleActionParametersCompressionAlgorithmBrotli},
+					{Name: RulesetRuleActionParametersCompressionAlgorithmGzip},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestSetResponseHeaderExpression(t *testing.T) {
+	rule := RulesetRule{
+		Action:           RulesetRuleActionRewrite,
+		Expression:       "true",
+		ActionParameters: SetResponseHeaderExpression("Server-Timing", `concat("cfRequestDuration;dur=", to_string(cf.timings.origin_ttfb_msec))`),
+	}
+
+	b, err := json.Marshal(rule)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"action": "rewrite",
+			"expression": "true",
+			"action_parameters": {
+				"headers": {
+					"Server-Timing": {
+						"operation": "set",
+						"expression": "concat(\"cfRequestDuration;dur=\", to_string(cf.timings.origin_ttfb_msec))"
+					}
+				}
+			}
+		}`, string(b))
+	}
+}
+
+func TestDeployManagedRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_firewall_managed/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"description": "zone entry point",
+				"rules": [
+					{
+						"id": "1bc2c6e4c8f14a5a9d8e0fa1c2bb6a11",
+						"action": "skip",
+						"expression": "ip.src eq 192.0.2.1",
+						"action_parameters": {"ruleset": "current"}
+					},
+					{
+						"action": "execute",
+						"expression": "http.host eq \"app.example.com\"",
+						"action_parameters": {
+							"id": "efb7b8c949ac4650a09736fc376e9aee",
+							"overrides": {"action": "log"}
+						}
+					}
+				]
+			}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"description": "zone entry point",
+				"kind": "zone",
+				"phase": "http_request_firewall_managed",
+				"rules": [
+					{
+						"id": "1bc2c6e4c8f14a5a9d8e0fa1c2bb6a11",
+						"action": "skip",
+						"expression": "ip.src eq 192.0.2.1",
+						"action_parameters": {"ruleset": "current"}
+					}
+				]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	_, err := client.DeployManagedRuleset(context.Background(), ZoneIdentifier(testZoneID), DeployManagedRulesetParams{})
+	assert.ErrorIs(t, err, ErrMissingManagedRulesetID)
+
+	_, err = client.DeployManagedRuleset(context.Background(), ZoneIdentifier(testZoneID), DeployManagedRulesetParams{
+		ID:         CloudflareManagedRulesetID,
+		Expression: `http.host eq "app.example.com"`,
+		Overrides:  &RulesetRuleActionParametersOverrides{Action: "log"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateCacheKeyRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"rules": [
+					{
+						"id": "3a03d665bac047339bb530ecb439a90d",
+						"action": "set_cache_settings",
+						"expression": "starts_with(http.request.uri.path, \"/static/\")",
+						"action_parameters": {"cache": true}
+					},
+					{
+						"id": "6b4b2ac7f1a74ed6a4e6c5c1a8c1c2d3",
+						"action": "set_cache_settings",
+						"expression": "true",
+						"action_parameters": {
+							"cache_key": {
+								"custom_key": {
+									"header": {"include": ["x-tenant"]},
+									"host": {"resolved": true}
+								}
+							}
+						}
+					}
+				]
+			}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "zone",
+				"phase": "http_request_cache_settings",
+				"rules": [
+					{
+						"id": "3a03d665bac047339bb530ecb439a90d",
+						"action": "set_cache_settings",
+						"expression": "starts_with(http.request.uri.path, \"/static/\")",
+						"action_parameters": {"cache": true}
+					},
+					{
+						"id": "6b4b2ac7f1a74ed6a4e6c5c1a8c1c2d3",
+						"action": "set_cache_settings",
+						"expression": "true",
+						"action_parameters": {"cache_key": {"cache_by_device_type": true}}
+					}
+				]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	_, err := client.UpdateCacheKeyRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{Expression: "true"}})
+	assert.ErrorIs(t, err, ErrMissingRulesetCacheKey)
+
+	_, err = client.UpdateCacheKeyRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression: "true",
+		ActionParameters: &RulesetRuleActionParameters{
+			CacheKey: &RulesetRuleActionParametersCacheKey{
+				CustomKey: &RulesetRuleActionParametersCustomKey{
+					Header: &RulesetRuleActionParametersCustomKeyHeader{
+						RulesetRuleActionParametersCustomKeyFields: RulesetRuleActionParametersCustomKeyFields{Include: []string{"x-tenant"}},
+					},
+					Host: &RulesetRuleActionParametersCustomKeyHost{Resolved: BoolPtr(true)},
+				},
+			},
+		},
+	}})
+	assert.NoError(t, err)
+}
+
+func TestUpdateCacheKeyRules_KeepsOtherCacheSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	liveRules := `[
+		{
+			"id": "3a03d665bac047339bb530ecb439a90d",
+			"action": "set_cache_settings",
+			"expression": "starts_with(http.request.uri.path, \"/api/\")",
+			"action_parameters": {
+				"cache": true,
+				"edge_ttl": {"mode": "override_origin", "default": 60},
+				"cache_key": {"cache_by_device_type": true}
+			}
+		},
+		{
+			"id": "7c5c3bd8f2b84fe7b5f7d6d2b9d2d3e4",
+			"action": "set_cache_settings",
+			"expression": "true",
+			"action_parameters": {"cache": false}
+		}
+	]`
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"rules": [
+					{
+						"id": "3a03d665bac047339bb530ecb439a90d",
+						"action": "set_cache_settings",
+						"expression": "starts_with(http.request.uri.path, \"/api/\")",
+						"action_parameters": {
+							"cache": true,
+							"edge_ttl": {"mode": "override_origin", "default": 60},
+							"cache_key": {"custom_key": {"header": {"include": ["x-tenant"]}}}
+						}
+					},
+					{
+						"id": "7c5c3bd8f2b84fe7b5f7d6d2b9d2d3e4",
+						"action": "set_cache_settings",
+						"expression": "true",
+						"action_parameters": {"cache": false}
+					}
+				]
+			}`, string(body))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "phase": "http_request_cache_settings", "rules": %s}}`, liveRules)
+	})
+
+	tenantKey := &RulesetRuleActionParametersCacheKey{
+		CustomKey: &RulesetRuleActionParametersCustomKey{
+			Header: &RulesetRuleActionParametersCustomKeyHeader{
+				RulesetRuleActionParametersCustomKeyFields: RulesetRuleActionParametersCustomKeyFields{Include: []string{"x-tenant"}},
+			},
+		},
+	}
+
+	_, err := client.UpdateCacheKeyRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression:       `starts_with(http.request.uri.path, "/api/")`,
+		ActionParameters: &RulesetRuleActionParameters{CacheKey: tenantKey},
+	}})
+	assert.NoError(t, err)
+
+	// Dropping the cache key of a rule that also sets a TTL would lose the TTL.
+	_, err = client.UpdateCacheKeyRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression:       `starts_with(http.request.uri.path, "/assets/")`,
+		ActionParameters: &RulesetRuleActionParameters{CacheKey: tenantKey},
+	}})
+	assert.ErrorIs(t, err, ErrRulesetCacheKeyRuleInUse)
+}
+
+func TestUpdateCacheRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rules": [{
+				"action": "set_cache_settings",
+				"expression": "starts_with(http.request.uri.path, \"/assets/\")",
+				"action_parameters": {
+					"cache": true,
+					"edge_ttl": {
+						"mode": "override_origin",
+						"default": 31536000,
+						"status_code_ttl": [{"status_code": 404, "value": 60}]
+					},
+					"browser_ttl": {"mode": "override_origin", "default": 31536000},
+					"serve_stale": {"disable_stale_while_updating": true},
+					"respect_strong_etags": true
+				}
+			}]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"kind": "zone",
+				"phase": "http_request_cache_settings",
+				"rules": []
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	year := uint(31536000)
+	_, err := client.UpdateCacheRules(context.Background(), ZoneIdentifier(testZoneID), []RulesetRule{{
+		Expression: `starts_with(http.request.uri.path, "/assets/")`,
+		ActionParameters: &RulesetRuleActionParameters{
+			Cache: BoolPtr(true),
+			EdgeTTL: &RulesetRuleActionParametersEdgeTTL{
+				Mode:    RulesetRuleActionParametersTTLModeOverrideOrigin,
+				Default: &year,
+				StatusCodeTTL: []RulesetRuleActionParametersStatusCodeTTL{
+					{StatusCodeValue: UintPtr(404), Value: IntPtr(60)},
+				},
+			},
+			BrowserTTL:         &RulesetRuleActionParametersBrowserTTL{Mode: RulesetRuleActionParametersTTLModeOverrideOrigin, Default: &year},
+			ServeStale:         &RulesetRuleActionParametersServeStale{DisableStaleWhileUpdating: BoolPtr(true)},
+			RespectStrongETags: BoolPtr(true),
+		},
+	}})
+	assert.NoError(t, err)
+}
+
+func TestDiffRuleset(t *testing.T) {
+	lastUpdated := time.Date(2024, time.September, 2, 10, 0, 0, 0, time.UTC)
+	live := []RulesetRule{
+		{
+			ID:          "3a03d665bac047339bb530ecb439a90d",
+			Ref:         "3a03d665bac047339bb530ecb439a90d",
+			Version:     StringPtr("2"),
+			LastUpdated: &lastUpdated,
+			Action:      RulesetRuleActionBlock,
+			Expression:  `(ip.src.country eq "T1")`,
+			Enabled:     BoolPtr(true),
+		},
+		{
+			ID:         "4a03d665bac047339bb530ecb439a90d",
+			Ref:        "admin-challenge",
+			Version:    StringPtr("1"),
+			Action:     RulesetRuleActionManagedChallenge,
+			Expression: `(http.request.uri.path contains "/admin")`,
+			Enabled:    BoolPtr(true),
+		},
+		{
+			ID:         "5a03d665bac047339bb530ecb439a90d",
+			Ref:        "5a03d665bac047339bb530ecb439a90d",
+			Action:     RulesetRuleActionLog,
+			Expression: "true",
+			Enabled:    BoolPtr(true),
+		},
+	}
+
+	// The live rules with the API managed fields stripped don't differ.
+	desired := []RulesetRule{
+		{Action: RulesetRuleActionBlock, Expression: `(ip.src.country eq "T1")`},
+		{Ref: "admin-challenge", Action: RulesetRuleActionManagedChallenge, Expression: `(http.request.uri.path contains "/admin")`},
+		{Action: RulesetRuleActionLog, Expression: "true", Enabled: BoolPtr(true)},
+	}
+	assert.True(t, DiffRuleset(desired, live).Empty())
+
+	desired = []RulesetRule{
+		{Ref: "admin-challenge", Action: RulesetRuleActionBlock, Expression: `(http.request.uri.path contains "/admin")`},
+		{Action: RulesetRuleActionBlock, Expression: `(ip.src.country eq "T1")`, Description: "Block Tor"},
+		{Action: RulesetRuleActionSkip, Expression: `(cf.client.bot)`},
+	}
+
+	diff := DiffRuleset(desired, live)
+	assert.False(t, diff.Empty())
+	assert.Equal(t, []RulesetRule{desired[2]}, diff.Added)
+	assert.Equal(t, []RulesetRule{live[2]}, diff.Removed)
+	assert.Equal(t, []RulesetRuleChange{
+		{Desired: desired[0], Live: live[1]},
+		{Desired: desired[1], Live: live[0]},
+	}, diff.Changed)
+	assert.True(t, diff.Reordered)
+}
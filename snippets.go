@@ -0,0 +1,352 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingSnippetName  = errors.New("required snippet name missing")
+	ErrMissingSnippetFiles = errors.New("at least one snippet file is required")
+)
+
+// Snippet is a small piece of JavaScript run at the edge for requests
+// matching one of the zone's snippet rules.
+type Snippet struct {
+	SnippetName string     `json:"snippet_name"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time `json:"modified_on,omitempty"`
+}
+
+// SnippetFile is a JavaScript module that is part of a snippet.
+type SnippetFile struct {
+	FileName string
+	Content  string
+}
+
+// SnippetParams are the files of a snippet to upload.
+type SnippetParams struct {
+	SnippetName string
+
+	// MainModule is the name of the file exporting the fetch handler. It
+	// defaults to the first of Files.
+	MainModule string
+
+	Files []SnippetFile
+}
+
+// SnippetRule runs the snippet for requests matching the Wirefilter
+// expression.
+type SnippetRule struct {
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	Expression  string `json:"expression"`
+	SnippetName string `json:"snippet_name"`
+}
+
+type SnippetResponse struct {
+	Response
+	Result Snippet `json:"result"`
+}
+
+type SnippetsResponse struct {
+	Response
+	Result []Snippet `json:"result"`
+}
+
+type SnippetRulesResponse struct {
+	Response
+	Result []SnippetRule `json:"result"`
+}
+
+type snippetRulesRequest struct {
+	Rules []SnippetRule `json:"rules"`
+}
+
+// ListSnippets returns the snippets of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets
+func (api *API) ListSnippets(ctx context.Context, rc *ResourceContainer) ([]Snippet, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []Snippet{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []Snippet{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Snippet{}, err
+	}
+
+	var r SnippetsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Snippet{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetSnippet returns the details of a snippet.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet
+func (api *API) GetSnippet(ctx context.Context, rc *ResourceContainer, snippetName string) (Snippet, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Snippet{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Snippet{}, ErrMissingZoneID
+	}
+
+	if snippetName == "" {
+		return Snippet{}, ErrMissingSnippetName
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/%s", rc.Identifier, snippetName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	var r SnippetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Snippet{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateOrUpdateSnippet uploads the files of a snippet, replacing the
+// existing snippet with the same name.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-put
+func (api *API) CreateOrUpdateSnippet(ctx context.Context, rc *ResourceContainer, params SnippetParams) (Snippet, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Snippet{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Snippet{}, ErrMissingZoneID
+	}
+
+	if params.SnippetName == "" {
+		return Snippet{}, ErrMissingSnippetName
+	}
+
+	if len(params.Files) == 0 {
+		return Snippet{}, ErrMissingSnippetFiles
+	}
+
+	contentType, body, err := formatSnippetMultipartBody(params)
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/%s", rc.Identifier, params.SnippetName)
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPut, uri, body, headers)
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	var r SnippetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Snippet{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetSnippetContent returns the JavaScript of a snippet's main module.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-content
+func (api *API) GetSnippetContent(ctx context.Context, rc *ResourceContainer, snippetName string) (string, error) {
+	if rc.Level != ZoneRouteLevel {
+		return "", ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return "", ErrMissingZoneID
+	}
+
+	if snippetName == "" {
+		return "", ErrMissingSnippetName
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/%s/content", rc.Identifier, snippetName)
+	res, err := api.makeRequestContextWithHeadersComplete(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	mediaType, mediaParams, _ := mime.ParseMediaType(res.Headers.Get("content-type"))
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return string(res.Body), nil
+	}
+
+	mimeReader := multipart.NewReader(bytes.NewReader(res.Body), mediaParams["boundary"])
+	mimePart, err := mimeReader.NextPart()
+	if err != nil {
+		return "", fmt.Errorf("could not get multipart response body: %w", err)
+	}
+	mimePartBody, err := io.ReadAll(mimePart)
+	if err != nil {
+		return "", fmt.Errorf("could not read multipart response body: %w", err)
+	}
+
+	return string(mimePartBody), nil
+}
+
+// DeleteSnippet deletes a snippet.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-delete
+func (api *API) DeleteSnippet(ctx context.Context, rc *ResourceContainer, snippetName string) error {
+	if rc.Level != ZoneRouteLevel {
+		return ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingZoneID
+	}
+
+	if snippetName == "" {
+		return ErrMissingSnippetName
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/%s", rc.Identifier, snippetName)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	return err
+}
+
+// ListSnippetRules returns the rules deciding which requests run which
+// snippets.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-rules
+func (api *API) ListSnippetRules(ctx context.Context, rc *ResourceContainer) ([]SnippetRule, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []SnippetRule{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []SnippetRule{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/snippet_rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []SnippetRule{}, err
+	}
+
+	var r SnippetRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SnippetRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSnippetRules replaces all of the snippet rules of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-rules-put
+func (api *API) UpdateSnippetRules(ctx context.Context, rc *ResourceContainer, rules []SnippetRule) ([]SnippetRule, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []SnippetRule{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []SnippetRule{}, ErrMissingZoneID
+	}
+
+	for _, rule := range rules {
+		if rule.SnippetName == "" {
+			return []SnippetRule{}, ErrMissingSnippetName
+		}
+	}
+
+	if rules == nil {
+		rules = []SnippetRule{}
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/snippet_rules", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, snippetRulesRequest{Rules: rules})
+	if err != nil {
+		return []SnippetRule{}, err
+	}
+
+	var r SnippetRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SnippetRule{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// formatSnippetMultipartBody returns the content type and body of the upload
+// of a snippet's metadata and files.
+func formatSnippetMultipartBody(params SnippetParams) (string, []byte, error) {
+	var buf = &bytes.Buffer{}
+	var mpw = multipart.NewWriter(buf)
+
+	mainModule := params.MainModule
+	if mainModule == "" {
+		mainModule = params.Files[0].FileName
+	}
+
+	metaJSON, err := json.Marshal(struct {
+		MainModule string `json:"main_module"`
+	}{mainModule})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var hdr = textproto.MIMEHeader{}
+	hdr.Set("content-disposition", `form-data; name="metadata"`)
+	hdr.Set("content-type", "application/json")
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err = pw.Write(metaJSON); err != nil {
+		return "", nil, err
+	}
+
+	for _, file := range params.Files {
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, file.FileName))
+		hdr.Set("content-type", "application/javascript+module")
+		pw, err = mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err = pw.Write([]byte(file.Content)); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err = mpw.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
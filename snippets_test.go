@@ -0,0 +1,161 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSnippets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "snippet_name": "add_headers",
+      "created_on": "2023-07-24T12:00:00Z",
+      "modified_on": "2023-07-25T12:00:00Z"
+    }
+  ]
+}`)
+	})
+
+	createdOn, _ := time.Parse(time.RFC3339, "2023-07-24T12:00:00Z")
+	modifiedOn, _ := time.Parse(time.RFC3339, "2023-07-25T12:00:00Z")
+	want := []Snippet{{SnippetName: "add_headers", CreatedOn: &createdOn, ModifiedOn: &modifiedOn}}
+
+	_, err := client.ListSnippets(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	actual, err := client.ListSnippets(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateOrUpdateSnippet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/add_headers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.JSONEq(t, `{"main_module": "main.js"}`, r.MultipartForm.Value["metadata"][0])
+
+		files := r.MultipartForm.File["main.js"]
+		require.Len(t, files, 1)
+		f, err := files[0].Open()
+		require.NoError(t, err)
+		content, _ := io.ReadAll(f)
+		assert.Equal(t, "export default { fetch(request) { return fetch(request) } }", string(content))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"snippet_name": "add_headers"}}`)
+	})
+
+	_, err := client.CreateOrUpdateSnippet(context.Background(), ZoneIdentifier(testZoneID), SnippetParams{SnippetName: "add_headers"})
+	assert.ErrorIs(t, err, ErrMissingSnippetFiles)
+
+	actual, err := client.CreateOrUpdateSnippet(context.Background(), ZoneIdentifier(testZoneID), SnippetParams{
+		SnippetName: "add_headers",
+		Files:       []SnippetFile{{FileName: "main.js", Content: "export default { fetch(request) { return fetch(request) } }"}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Snippet{SnippetName: "add_headers"}, actual)
+	}
+}
+
+func TestGetSnippetContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/add_headers/content", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "multipart/form-data; boundary=snippetboundary")
+		fmt.Fprint(w, "--snippetboundary\r\n"+
+			"Content-Disposition: form-data; name=\"main.js\"; filename=\"main.js\"\r\n"+
+			"Content-Type: application/javascript+module\r\n\r\n"+
+			"export default {}\r\n"+
+			"--snippetboundary--\r\n")
+	})
+
+	actual, err := client.GetSnippetContent(context.Background(), ZoneIdentifier(testZoneID), "add_headers")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "export default {}", actual)
+	}
+}
+
+func TestDeleteSnippet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/add_headers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+
+	err := client.DeleteSnippet(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingSnippetName)
+
+	err = client.DeleteSnippet(context.Background(), ZoneIdentifier(testZoneID), "add_headers")
+	assert.NoError(t, err)
+}
+
+func TestSnippetRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/snippet_rules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"rules": [{"description": "Add headers to API requests", "enabled": true, "expression": "starts_with(http.request.uri.path, \"/api\")", "snippet_name": "add_headers"}]}`, string(body))
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "description": "Add headers to API requests",
+      "enabled": true,
+      "expression": "starts_with(http.request.uri.path, \"/api\")",
+      "snippet_name": "add_headers"
+    }
+  ]
+}`)
+	})
+
+	want := []SnippetRule{{
+		Description: "Add headers to API requests",
+		Enabled:     true,
+		Expression:  `starts_with(http.request.uri.path, "/api")`,
+		SnippetName: "add_headers",
+	}}
+
+	actual, err := client.ListSnippetRules(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	actual, err = client.UpdateSnippetRules(context.Background(), ZoneIdentifier(testZoneID), want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,148 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	defaultSpectrumAnalyticsEventDimensions = []string{"event", "appID"}
+	defaultSpectrumAnalyticsEventMetrics    = []string{"count", "bytesIngress", "bytesEgress", "durationAvg"}
+)
+
+// GetSpectrumAnalyticsEventsParams selects the connection events returned by
+// GetSpectrumAnalyticsEvents.
+type GetSpectrumAnalyticsEventsParams struct {
+	// AppID limits the events to a single Spectrum application.
+	AppID string `url:"-"`
+
+	// Dimensions defaults to `event` and `appID`.
+	Dimensions []string `url:"dimensions,omitempty" del:","`
+
+	// Metrics defaults to `count`, `bytesIngress`, `bytesEgress` and
+	// `durationAvg`.
+	Metrics []string   `url:"metrics,omitempty" del:","`
+	Since   *time.Time `url:"since,omitempty"`
+	Until   *time.Time `url:"until,omitempty"`
+
+	// TimeDelta is the granularity of the events, for example `minute`.
+	TimeDelta string `url:"time_delta,omitempty"`
+
+	Filters string `url:"filters,omitempty"`
+}
+
+// SpectrumAnalyticsEvent is the set of connection events, such as `connect`
+// or `disconnect`, that occurred for a combination of dimensions within a
+// single time interval.
+type SpectrumAnalyticsEvent struct {
+	Time       time.Time
+	Dimensions map[string]string
+	Metrics    map[string]float64
+}
+
+type spectrumAnalyticsEventsByTime struct {
+	Data []struct {
+		Dimensions []string    `json:"dimensions"`
+		Metrics    [][]float64 `json:"metrics"`
+	} `json:"data"`
+	Query struct {
+		Dimensions []string `json:"dimensions"`
+		Metrics    []string `json:"metrics"`
+	} `json:"query"`
+	TimeIntervals [][]time.Time `json:"time_intervals"`
+}
+
+type spectrumAnalyticsEventsByTimeResponse struct {
+	Response
+	Result spectrumAnalyticsEventsByTime `json:"result"`
+}
+
+// GetSpectrumAnalyticsEvents returns the connection events of the Spectrum
+// applications in a zone, one entry per time interval and dimension
+// combination, ordered by time. Unlike aggregated reports this makes short
+// lived bursts of connections visible.
+//
+// API reference: https://developers.cloudflare.com/api/operations/spectrum-analytics-(-by-time)-get-analytics-by-time
+func (api *API) GetSpectrumAnalyticsEvents(ctx context.Context, rc *ResourceContainer, params GetSpectrumAnalyticsEventsParams) ([]SpectrumAnalyticsEvent, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []SpectrumAnalyticsEvent{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []SpectrumAnalyticsEvent{}, ErrMissingZoneID
+	}
+
+	if len(params.Dimensions) == 0 {
+		params.Dimensions = defaultSpectrumAnalyticsEventDimensions
+	}
+
+	if len(params.Metrics) == 0 {
+		params.Metrics = defaultSpectrumAnalyticsEventMetrics
+	}
+
+	if params.AppID != "" {
+		appFilter := "appID==" + params.AppID
+		if params.Filters != "" {
+			params.Filters = params.Filters + ";" + appFilter
+		} else {
+			params.Filters = appFilter
+		}
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/spectrum/analytics/events/bytime", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []SpectrumAnalyticsEvent{}, err
+	}
+
+	var r spectrumAnalyticsEventsByTimeResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SpectrumAnalyticsEvent{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	dimensions := r.Result.Query.Dimensions
+	if len(dimensions) == 0 {
+		dimensions = params.Dimensions
+	}
+
+	metrics := r.Result.Query.Metrics
+	if len(metrics) == 0 {
+		metrics = params.Metrics
+	}
+
+	events := []SpectrumAnalyticsEvent{}
+	for i, interval := range r.Result.TimeIntervals {
+		if len(interval) == 0 {
+			continue
+		}
+
+		for _, row := range r.Result.Data {
+			event := SpectrumAnalyticsEvent{
+				Time:       interval[0],
+				Dimensions: make(map[string]string, len(row.Dimensions)),
+				Metrics:    make(map[string]float64, len(row.Metrics)),
+			}
+
+			for d, value := range row.Dimensions {
+				if d < len(dimensions) {
+					event.Dimensions[dimensions[d]] = value
+				}
+			}
+
+			for m, values := range row.Metrics {
+				if m < len(metrics) && i < len(values) {
+					event.Metrics[metrics[m]] = values[i]
+				}
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
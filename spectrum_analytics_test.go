@@ -0,0 +1,71 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSpectrumAnalyticsEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	until := since.Add(2 * time.Minute)
+
+	mux.HandleFunc("/zones/"+testZoneID+"/spectrum/analytics/events/bytime", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "event,appID", r.URL.Query().Get("dimensions"))
+		assert.Equal(t, "count,bytesIngress,bytesEgress,durationAvg", r.URL.Query().Get("metrics"))
+		assert.Equal(t, "appID==ea95132c15732412d22c1476fa83f27a", r.URL.Query().Get("filters"))
+		assert.Equal(t, "minute", r.URL.Query().Get("time_delta"))
+		assert.Equal(t, "2023-11-01T10:00:00Z", r.URL.Query().Get("since"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"rows": 2,
+				"data": [
+					{"dimensions": ["connect", "ea95132c15732412d22c1476fa83f27a"], "metrics": [[120, 3], [1024, 16], [2048, 32], [10.5, 2]]},
+					{"dimensions": ["disconnect", "ea95132c15732412d22c1476fa83f27a"], "metrics": [[118, 5], [0, 0], [0, 0], [0, 0]]}
+				],
+				"query": {
+					"dimensions": ["event", "appID"],
+					"metrics": ["count", "bytesIngress", "bytesEgress", "durationAvg"],
+					"time_delta": "minute"
+				},
+				"time_intervals": [
+					["2023-11-01T10:00:00Z", "2023-11-01T10:00:59Z"],
+					["2023-11-01T10:01:00Z", "2023-11-01T10:01:59Z"]
+				]
+			}
+		}`)
+	})
+
+	_, err := client.GetSpectrumAnalyticsEvents(context.Background(), AccountIdentifier(testAccountID), GetSpectrumAnalyticsEventsParams{})
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	events, err := client.GetSpectrumAnalyticsEvents(context.Background(), ZoneIdentifier(testZoneID), GetSpectrumAnalyticsEventsParams{
+		AppID:     "ea95132c15732412d22c1476fa83f27a",
+		Since:     &since,
+		Until:     &until,
+		TimeDelta: "minute",
+	})
+	if assert.NoError(t, err) && assert.Len(t, events, 4) {
+		assert.Equal(t, SpectrumAnalyticsEvent{
+			Time:       since,
+			Dimensions: map[string]string{"event": "connect", "appID": "ea95132c15732412d22c1476fa83f27a"},
+			Metrics:    map[string]float64{"count": 120, "bytesIngress": 1024, "bytesEgress": 2048, "durationAvg": 10.5},
+		}, events[0])
+		assert.Equal(t, "disconnect", events[1].Dimensions["event"])
+		assert.Equal(t, since.Add(time.Minute), events[3].Time)
+		assert.Equal(t, float64(5), events[3].Metrics["count"])
+	}
+}
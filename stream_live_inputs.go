@@ -0,0 +1,377 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	// ErrMissingStreamLiveInputID is for when a live input ID is required but missing.
+	ErrMissingStreamLiveInputID = errors.New("required live input id missing")
+	// ErrMissingStreamLiveInputOutputID is for when a live input output ID is required but missing.
+	ErrMissingStreamLiveInputOutputID = errors.New("required live input output id missing")
+	// ErrMissingStreamLiveInputOutputURL is for when an output URL is required but missing.
+	ErrMissingStreamLiveInputOutputURL = errors.New("required live input output url missing")
+	// ErrInvalidStreamLiveInputRecordingMode is for when the recording mode is not supported.
+	ErrInvalidStreamLiveInputRecordingMode = errors.New("invalid live input recording mode, must be one of: automatic, off")
+)
+
+// StreamLiveInputRecordingMode controls whether broadcasts to a live input
+// are recorded.
+type StreamLiveInputRecordingMode string
+
+const (
+	StreamLiveInputRecordingModeAutomatic StreamLiveInputRecordingMode = "automatic"
+	StreamLiveInputRecordingModeOff       StreamLiveInputRecordingMode = "off"
+)
+
+// StreamLiveInputRecording represents the recording settings of a live input.
+type StreamLiveInputRecording struct {
+	Mode                StreamLiveInputRecordingMode `json:"mode,omitempty"`
+	RequireSignedURLs   *bool                        `json:"requireSignedURLs,omitempty"`
+	AllowedOrigins      []string                     `json:"allowedOrigins,omitempty"`
+	TimeoutSeconds      int                          `json:"timeoutSeconds,omitempty"`
+	HideLiveViewerCount *bool                        `json:"hideLiveViewerCount,omitempty"`
+}
+
+// StreamLiveInputRTMPS represents the RTMPS URL and stream key of a live
+// input.
+type StreamLiveInputRTMPS struct {
+	URL       string `json:"url,omitempty"`
+	StreamKey string `json:"streamKey,omitempty"`
+}
+
+// StreamLiveInputSRT represents the SRT URL and credentials of a live input.
+type StreamLiveInputSRT struct {
+	URL        string `json:"url,omitempty"`
+	StreamID   string `json:"streamId,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// StreamLiveInputWebRTC represents the WebRTC (WHIP/WHEP) URL of a live
+// input.
+type StreamLiveInputWebRTC struct {
+	URL string `json:"url,omitempty"`
+}
+
+// StreamLiveInput represents a Stream live input. The RTMPS, SRT and WebRTC
+// fields are used for ingest, the corresponding playback fields for
+// playback.
+type StreamLiveInput struct {
+	UID                      string                   `json:"uid"`
+	Created                  *time.Time               `json:"created,omitempty"`
+	Modified                 *time.Time               `json:"modified,omitempty"`
+	Meta                     map[string]interface{}   `json:"meta,omitempty"`
+	Recording                StreamLiveInputRecording `json:"recording,omitempty"`
+	DefaultCreator           string                   `json:"defaultCreator,omitempty"`
+	DeleteRecordingAfterDays int                      `json:"deleteRecordingAfterDays,omitempty"`
+	RTMPS                    StreamLiveInputRTMPS     `json:"rtmps,omitempty"`
+	RTMPSPlayback            StreamLiveInputRTMPS     `json:"rtmpsPlayback,omitempty"`
+	SRT                      StreamLiveInputSRT       `json:"srt,omitempty"`
+	SRTPlayback              StreamLiveInputSRT       `json:"srtPlayback,omitempty"`
+	WebRTC                   StreamLiveInputWebRTC    `json:"webRTC,omitempty"`
+	WebRTCPlayback           StreamLiveInputWebRTC    `json:"webRTCPlayback,omitempty"`
+}
+
+// StreamLiveInputParams are the parameters used when creating or updating a
+// live input.
+type StreamLiveInputParams struct {
+	DefaultCreator           string                   `json:"defaultCreator,omitempty"`
+	DeleteRecordingAfterDays int                      `json:"deleteRecordingAfterDays,omitempty"`
+	Meta                     map[string]interface{}   `json:"meta,omitempty"`
+	Recording                StreamLiveInputRecording `json:"recording"`
+}
+
+// StreamLiveInputOutput represents an output a live input is simulcast to.
+type StreamLiveInputOutput struct {
+	UID       string `json:"uid"`
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+}
+
+// StreamLiveInputOutputParams are the parameters used when creating a live
+// input output.
+type StreamLiveInputOutputParams struct {
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+}
+
+// StreamLiveInputResponse represents an API response of a live input.
+type StreamLiveInputResponse struct {
+	Response
+	Result StreamLiveInput `json:"result"`
+}
+
+// StreamLiveInputListResponse represents an API response of listing live
+// inputs.
+type StreamLiveInputListResponse struct {
+	Response
+	Result struct {
+		LiveInputs []StreamLiveInput `json:"liveInputs"`
+		Range      int               `json:"range"`
+		Total      int               `json:"total"`
+	} `json:"result"`
+}
+
+// StreamLiveInputOutputResponse represents an API response of a live input
+// output.
+type StreamLiveInputOutputResponse struct {
+	Response
+	Result StreamLiveInputOutput `json:"result"`
+}
+
+// StreamLiveInputOutputListResponse represents an API response of listing
+// live input outputs.
+type StreamLiveInputOutputListResponse struct {
+	Response
+	Result []StreamLiveInputOutput `json:"result"`
+}
+
+// ListStreamLiveInputs returns the live inputs of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-list-live-inputs
+func (api *API) ListStreamLiveInputs(ctx context.Context, rc *ResourceContainer) ([]StreamLiveInput, error) {
+	if rc.Level != AccountRouteLevel {
+		return []StreamLiveInput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []StreamLiveInput{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamLiveInput{}, err
+	}
+
+	var r StreamLiveInputListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamLiveInput{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.LiveInputs, nil
+}
+
+// CreateStreamLiveInput creates a live input and returns its RTMPS, SRT and
+// WebRTC ingest URLs and keys.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-create-a-live-input
+func (api *API) CreateStreamLiveInput(ctx context.Context, rc *ResourceContainer, params StreamLiveInputParams) (StreamLiveInput, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamLiveInput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamLiveInput{}, ErrMissingAccountID
+	}
+
+	if err := validateStreamLiveInputRecordingMode(params.Recording.Mode); err != nil {
+		return StreamLiveInput{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return StreamLiveInput{}, err
+	}
+
+	return unmarshalStreamLiveInput(res)
+}
+
+// GetStreamLiveInput returns a single live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-retrieve-a-live-input
+func (api *API) GetStreamLiveInput(ctx context.Context, rc *ResourceContainer, liveInputID string) (StreamLiveInput, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamLiveInput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamLiveInput{}, ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return StreamLiveInput{}, ErrMissingStreamLiveInputID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s", rc.Identifier, liveInputID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return StreamLiveInput{}, err
+	}
+
+	return unmarshalStreamLiveInput(res)
+}
+
+// UpdateStreamLiveInput updates the settings of a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-update-a-live-input
+func (api *API) UpdateStreamLiveInput(ctx context.Context, rc *ResourceContainer, liveInputID string, params StreamLiveInputParams) (StreamLiveInput, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamLiveInput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamLiveInput{}, ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return StreamLiveInput{}, ErrMissingStreamLiveInputID
+	}
+
+	if err := validateStreamLiveInputRecordingMode(params.Recording.Mode); err != nil {
+		return StreamLiveInput{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s", rc.Identifier, liveInputID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return StreamLiveInput{}, err
+	}
+
+	return unmarshalStreamLiveInput(res)
+}
+
+// DeleteStreamLiveInput deletes a live input. Existing recordings are kept.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-delete-a-live-input
+func (api *API) DeleteStreamLiveInput(ctx context.Context, rc *ResourceContainer, liveInputID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return ErrMissingStreamLiveInputID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s", rc.Identifier, liveInputID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	return err
+}
+
+// ListStreamLiveInputOutputs returns the outputs a live input is simulcast
+// to.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-list-all-outputs-associated-with-a-specified-live-input
+func (api *API) ListStreamLiveInputOutputs(ctx context.Context, rc *ResourceContainer, liveInputID string) ([]StreamLiveInputOutput, error) {
+	if rc.Level != AccountRouteLevel {
+		return []StreamLiveInputOutput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []StreamLiveInputOutput{}, ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return []StreamLiveInputOutput{}, ErrMissingStreamLiveInputID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s/outputs", rc.Identifier, liveInputID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamLiveInputOutput{}, err
+	}
+
+	var r StreamLiveInputOutputListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamLiveInputOutput{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateStreamLiveInputOutput adds an RTMP(S) or SRT destination the live
+// input is simulcast to.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-create-a-new-output,-connected-to-a-live-input
+func (api *API) CreateStreamLiveInputOutput(ctx context.Context, rc *ResourceContainer, liveInputID string, params StreamLiveInputOutputParams) (StreamLiveInputOutput, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamLiveInputOutput{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamLiveInputOutput{}, ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return StreamLiveInputOutput{}, ErrMissingStreamLiveInputID
+	}
+
+	if params.URL == "" {
+		return StreamLiveInputOutput{}, ErrMissingStreamLiveInputOutputURL
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s/outputs", rc.Identifier, liveInputID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return StreamLiveInputOutput{}, err
+	}
+
+	var r StreamLiveInputOutputResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamLiveInputOutput{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteStreamLiveInputOutput removes an output from a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-delete-an-output
+func (api *API) DeleteStreamLiveInputOutput(ctx context.Context, rc *ResourceContainer, liveInputID, outputID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if liveInputID == "" {
+		return ErrMissingStreamLiveInputID
+	}
+
+	if outputID == "" {
+		return ErrMissingStreamLiveInputOutputID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs/%s/outputs/%s", rc.Identifier, liveInputID, outputID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	return err
+}
+
+func validateStreamLiveInputRecordingMode(mode StreamLiveInputRecordingMode) error {
+	switch mode {
+	case "", StreamLiveInputRecordingModeAutomatic, StreamLiveInputRecordingModeOff:
+		return nil
+	default:
+		return ErrInvalidStreamLiveInputRecordingMode
+	}
+}
+
+func unmarshalStreamLiveInput(res []byte) (StreamLiveInput, error) {
+	var r StreamLiveInputResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamLiveInput{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
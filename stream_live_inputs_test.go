@@ -0,0 +1,192 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testStreamLiveInputID       = "66be4bf738797e01e1fca35a7bdecdcd"
+	testStreamLiveInputOutputID = "baea4d9c515887b80289d5c33cf01145"
+)
+
+func TestCreateStreamLiveInput(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/live_inputs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "defaultCreator": "events-team",
+  "meta": {"name": "keynote"},
+  "recording": {"mode": "automatic", "timeoutSeconds": 60}
+}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "uid": "%s",
+    "created": "2024-04-01T10:00:00Z",
+    "modified": "2024-04-01T10:00:00Z",
+    "meta": {"name": "keynote"},
+    "defaultCreator": "events-team",
+    "recording": {"mode": "automatic", "timeoutSeconds": 60},
+    "rtmps": {"url": "rtmps://live.cloudflare.com:443/live/", "streamKey": "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada"},
+    "srt": {"url": "srt://live.cloudflare.com:778", "streamId": "f256e6ea9341d51eea64c9454659e576", "passphrase": "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada"},
+    "webRTC": {"url": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/b236bde30eb07b9d01318940e5fc3edake34a3efb3896e18f2dc277ce6cc993ad/webRTC/publish"}
+  }
+}`, testStreamLiveInputID)
+	})
+
+	created := time.Date(2024, time.April, 1, 10, 0, 0, 0, time.UTC)
+	want := StreamLiveInput{
+		UID:            testStreamLiveInputID,
+		Created:        &created,
+		Modified:       &created,
+		Meta:           map[string]interface{}{"name": "keynote"},
+		DefaultCreator: "events-team",
+		Recording: StreamLiveInputRecording{
+			Mode:           StreamLiveInputRecordingModeAutomatic,
+			TimeoutSeconds: 60,
+		},
+		RTMPS: StreamLiveInputRTMPS{
+			URL:       "rtmps://live.cloudflare.com:443/live/",
+			StreamKey: "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada",
+		},
+		SRT: StreamLiveInputSRT{
+			URL:        "srt://live.cloudflare.com:778",
+			StreamID:   "f256e6ea9341d51eea64c9454659e576",
+			Passphrase: "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada",
+		},
+		WebRTC: StreamLiveInputWebRTC{
+			URL: "https://customer-m033z5x00ks6nunl.cloudflarestream.com/b236bde30eb07b9d01318940e5fc3edake34a3efb3896e18f2dc277ce6cc993ad/webRTC/publish",
+		},
+	}
+
+	actual, err := client.CreateStreamLiveInput(context.Background(), AccountIdentifier(testAccountID), StreamLiveInputParams{
+		DefaultCreator: "events-team",
+		Meta:           map[string]interface{}{"name": "keynote"},
+		Recording: StreamLiveInputRecording{
+			Mode:           StreamLiveInputRecordingModeAutomatic,
+			TimeoutSeconds: 60,
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreateStreamLiveInput(context.Background(), AccountIdentifier(testAccountID), StreamLiveInputParams{
+		Recording: StreamLiveInputRecording{Mode: "manual"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidStreamLiveInputRecordingMode)
+}
+
+func TestListStreamLiveInputs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/live_inputs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "liveInputs": [
+      {"uid": "%s", "meta": {"name": "keynote"}, "deleteRecordingAfterDays": 45}
+    ],
+    "range": 1000,
+    "total": 1
+  }
+}`, testStreamLiveInputID)
+	})
+
+	want := []StreamLiveInput{{
+		UID:                      testStreamLiveInputID,
+		Meta:                     map[string]interface{}{"name": "keynote"},
+		DeleteRecordingAfterDays: 45,
+	}}
+
+	_, err := client.ListStreamLiveInputs(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	actual, err := client.ListStreamLiveInputs(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteStreamLiveInput(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/live_inputs/"+testStreamLiveInputID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+
+	err := client.DeleteStreamLiveInput(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingStreamLiveInputID)
+
+	err = client.DeleteStreamLiveInput(context.Background(), AccountIdentifier(testAccountID), testStreamLiveInputID)
+	assert.NoError(t, err)
+}
+
+func TestCreateStreamLiveInputOutput(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/live_inputs/"+testStreamLiveInputID+"/outputs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "url": "rtmp://a.rtmp.youtube.com/live2",
+  "streamKey": "uzya-f19y-g2g9-a2ee-51j2",
+  "enabled": true
+}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "uid": "%s",
+    "url": "rtmp://a.rtmp.youtube.com/live2",
+    "streamKey": "uzya-f19y-g2g9-a2ee-51j2",
+    "enabled": true
+  }
+}`, testStreamLiveInputOutputID)
+	})
+
+	want := StreamLiveInputOutput{
+		UID:       testStreamLiveInputOutputID,
+		URL:       "rtmp://a.rtmp.youtube.com/live2",
+		StreamKey: "uzya-f19y-g2g9-a2ee-51j2",
+		Enabled:   BoolPtr(true),
+	}
+
+	_, err := client.CreateStreamLiveInputOutput(context.Background(), AccountIdentifier(testAccountID), testStreamLiveInputID, StreamLiveInputOutputParams{})
+	assert.ErrorIs(t, err, ErrMissingStreamLiveInputOutputURL)
+
+	actual, err := client.CreateStreamLiveInputOutput(context.Background(), AccountIdentifier(testAccountID), testStreamLiveInputID, StreamLiveInputOutputParams{
+		URL:       "rtmp://a.rtmp.youtube.com/live2",
+		StreamKey: "uzya-f19y-g2g9-a2ee-51j2",
+		Enabled:   BoolPtr(true),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
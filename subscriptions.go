@@ -0,0 +1,213 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// SubscriptionRatePlan is the rate plan a subscription is billed against.
+type SubscriptionRatePlan struct {
+	ID                string   `json:"id,omitempty"`
+	PublicName        string   `json:"public_name,omitempty"`
+	Currency          string   `json:"currency,omitempty"`
+	Scope             string   `json:"scope,omitempty"`
+	Sets              []string `json:"sets,omitempty"`
+	IsContract        *bool    `json:"is_contract,omitempty"`
+	ExternallyManaged *bool    `json:"externally_managed,omitempty"`
+}
+
+// SubscriptionComponent is a purchasable component of a subscription, such
+// as additional page rules.
+type SubscriptionComponent struct {
+	Name    string  `json:"name"`
+	Value   float64 `json:"value"`
+	Default float64 `json:"default,omitempty"`
+	Price   float64 `json:"price,omitempty"`
+}
+
+// SubscriptionZone is the zone a subscription applies to.
+type SubscriptionZone struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Subscription represents a zone or account level subscription.
+type Subscription struct {
+	ID                 string                  `json:"id,omitempty"`
+	State              string                  `json:"state,omitempty"`
+	Price              float64                 `json:"price,omitempty"`
+	Currency           string                  `json:"currency,omitempty"`
+	Frequency          string                  `json:"frequency,omitempty"`
+	RatePlan           *SubscriptionRatePlan   `json:"rate_plan,omitempty"`
+	ComponentValues    []SubscriptionComponent `json:"component_values,omitempty"`
+	Zone               *SubscriptionZone       `json:"zone,omitempty"`
+	CurrentPeriodStart *time.Time              `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *time.Time              `json:"current_period_end,omitempty"`
+}
+
+// SubscriptionResponse represents the response from a single subscription
+// endpoint.
+type SubscriptionResponse struct {
+	Response
+	Result Subscription `json:"result"`
+}
+
+// ZoneSubscriptionUpdateParams contains the fields used to change the plan of
+// a zone.
+type ZoneSubscriptionUpdateParams struct {
+	RatePlan        SubscriptionRatePlan    `json:"rate_plan"`
+	Frequency       string                  `json:"frequency,omitempty"`
+	ComponentValues []SubscriptionComponent `json:"component_values,omitempty"`
+}
+
+// ZoneSubscription returns the subscription of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-subscription-zone-subscription-details
+func (api *API) ZoneSubscription(ctx context.Context, zoneID string) (Subscription, error) {
+	if zoneID == "" {
+		return Subscription{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateZoneSubscription changes the rate plan, billing frequency or
+// components of a zone's subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-subscription-update-zone-subscription
+func (api *API) UpdateZoneSubscription(ctx context.Context, zoneID string, params ZoneSubscriptionUpdateParams) (Subscription, error) {
+	if zoneID == "" {
+		return Subscription{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// SubscriptionsResponse represents the response from the list subscriptions
+// endpoint.
+type SubscriptionsResponse struct {
+	Response
+	Result []Subscription `json:"result"`
+}
+
+// AccountSubscriptions lists all subscriptions for an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-list-subscriptions
+func (api *API) AccountSubscriptions(ctx context.Context, accountID string) ([]Subscription, error) {
+	if accountID == "" {
+		return []Subscription{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Subscription{}, err
+	}
+
+	var r SubscriptionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateAccountSubscription creates a new subscription on an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-create-subscription
+func (api *API) CreateAccountSubscription(ctx context.Context, accountID string, sub Subscription) (Subscription, error) {
+	if accountID == "" {
+		return Subscription{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, sub)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateAccountSubscription updates an existing account subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-update-subscription
+func (api *API) UpdateAccountSubscription(ctx context.Context, accountID string, sub Subscription) (Subscription, error) {
+	if accountID == "" {
+		return Subscription{}, ErrMissingAccountID
+	}
+
+	if sub.ID == "" {
+		return Subscription{}, ErrMissingResourceIdentifier
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", accountID, sub.ID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, sub)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteAccountSubscription deletes an account subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-delete-subscription
+func (api *API) DeleteAccountSubscription(ctx context.Context, accountID, subscriptionID string) error {
+	if accountID == "" {
+		return ErrMissingAccountID
+	}
+
+	if subscriptionID == "" {
+		return ErrMissingResourceIdentifier
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", accountID, subscriptionID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
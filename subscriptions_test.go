@@ -0,0 +1,284 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSubscriptionID = "506e3185e9c882d175a2d0cb0093d9f2"
+
+var (
+	subscriptionPeriodStart, _ = time.Parse(time.RFC3339, "2023-11-01T00:00:00Z")
+	subscriptionPeriodEnd, _   = time.Parse(time.RFC3339, "2023-12-01T00:00:00Z")
+)
+
+func TestZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "%s",
+    "state": "Paid",
+    "price": 20,
+    "currency": "USD",
+    "frequency": "monthly",
+    "rate_plan": {
+      "id": "pro",
+      "public_name": "Pro Plan",
+      "currency": "USD",
+      "scope": "zone",
+      "externally_managed": false
+    },
+    "component_values": [
+      {"name": "page_rules", "value": 20, "default": 20, "price": 0}
+    ],
+    "zone": {"id": "%s", "name": "example.com"},
+    "current_period_start": "2023-11-01T00:00:00Z",
+    "current_period_end": "2023-12-01T00:00:00Z"
+  }
+}`, testSubscriptionID, testZoneID)
+	})
+
+	want := Subscription{
+		ID:        testSubscriptionID,
+		State:     "Paid",
+		Price:     20,
+		Currency:  "USD",
+		Frequency: "monthly",
+		RatePlan: &SubscriptionRatePlan{
+			ID:                "pro",
+			PublicName:        "Pro Plan",
+			Currency:          "USD",
+			Scope:             "zone",
+			ExternallyManaged: BoolPtr(false),
+		},
+		ComponentValues: []SubscriptionComponent{
+			{Name: "page_rules", Value: 20, Default: 20},
+		},
+		Zone:               &SubscriptionZone{ID: testZoneID, Name: "example.com"},
+		CurrentPeriodStart: &subscriptionPeriodStart,
+		CurrentPeriodEnd:   &subscriptionPeriodEnd,
+	}
+
+	actual, err := client.ZoneSubscription(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ZoneSubscription(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+}
+
+func TestUpdateZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"rate_plan": {"id": "business"},
+			"frequency": "yearly",
+			"component_values": [{"name": "page_rules", "value": 50}]
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "%s",
+    "state": "Paid",
+    "price": 2400,
+    "currency": "USD",
+    "frequency": "yearly",
+    "rate_plan": {"id": "business"},
+    "component_values": [{"name": "page_rules", "value": 50}]
+  }
+}`, testSubscriptionID)
+	})
+
+	want := Subscription{
+		ID:              testSubscriptionID,
+		State:           "Paid",
+		Price:           2400,
+		Currency:        "USD",
+		Frequency:       "yearly",
+		RatePlan:        &SubscriptionRatePlan{ID: "business"},
+		ComponentValues: []SubscriptionComponent{{Name: "page_rules", Value: 50}},
+	}
+
+	actual, err := client.UpdateZoneSubscription(context.Background(), testZoneID, ZoneSubscriptionUpdateParams{
+		RatePlan:        SubscriptionRatePlan{ID: "business"},
+		Frequency:       "yearly",
+		ComponentValues: []SubscriptionComponent{{Name: "page_rules", Value: 50}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestAccountSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "%s",
+      "state": "Paid",
+      "price": 5,
+      "currency": "USD",
+      "frequency": "monthly",
+      "rate_plan": {"id": "workers_paid", "public_name": "Workers Paid", "scope": "account"},
+      "current_period_start": "2023-11-01T00:00:00Z",
+      "current_period_end": "2023-12-01T00:00:00Z"
+    }
+  ]
+}`, testSubscriptionID)
+	})
+
+	want := []Subscription{
+		{
+			ID:                 testSubscriptionID,
+			State:              "Paid",
+			Price:              5,
+			Currency:           "USD",
+			Frequency:          "monthly",
+			RatePlan:           &SubscriptionRatePlan{ID: "workers_paid", PublicName: "Workers Paid", Scope: "account"},
+			CurrentPeriodStart: &subscriptionPeriodStart,
+			CurrentPeriodEnd:   &subscriptionPeriodEnd,
+		},
+	}
+
+	actual, err := client.AccountSubscriptions(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.AccountSubscriptions(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingAccountID)
+}
+
+func TestCreateAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"frequency": "monthly", "rate_plan": {"id": "workers_paid"}}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "%s",
+    "state": "Paid",
+    "frequency": "monthly",
+    "rate_plan": {"id": "workers_paid"}
+  }
+}`, testSubscriptionID)
+	})
+
+	want := Subscription{
+		ID:        testSubscriptionID,
+		State:     "Paid",
+		Frequency: "monthly",
+		RatePlan:  &SubscriptionRatePlan{ID: "workers_paid"},
+	}
+
+	actual, err := client.CreateAccountSubscription(context.Background(), testAccountID, Subscription{
+		Frequency: "monthly",
+		RatePlan:  &SubscriptionRatePlan{ID: "workers_paid"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions/"+testSubscriptionID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "%s",
+    "state": "Paid",
+    "frequency": "yearly",
+    "rate_plan": {"id": "workers_paid"}
+  }
+}`, testSubscriptionID)
+	})
+
+	want := Subscription{
+		ID:        testSubscriptionID,
+		State:     "Paid",
+		Frequency: "yearly",
+		RatePlan:  &SubscriptionRatePlan{ID: "workers_paid"},
+	}
+
+	actual, err := client.UpdateAccountSubscription(context.Background(), testAccountID, Subscription{
+		ID:        testSubscriptionID,
+		Frequency: "yearly",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateAccountSubscription(context.Background(), testAccountID, Subscription{})
+	assert.ErrorIs(t, err, ErrMissingResourceIdentifier)
+}
+
+func TestDeleteAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions/"+testSubscriptionID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {"subscription_id": "%s"}
+}`, testSubscriptionID)
+	})
+
+	err := client.DeleteAccountSubscription(context.Background(), testAccountID, testSubscriptionID)
+	assert.NoError(t, err)
+}
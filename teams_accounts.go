@@ -270,3 +270,53 @@ func (api *API) TeamsAccountDeviceUpdateConfiguration(ctx context.Context, accou
 
 	return teamsDeviceResponse.Result, nil
 }
+
+type gatewayConfigurationPatchRequest struct {
+	Settings TeamsAccountSettings `json:"settings"`
+}
+
+// GetGatewayConfiguration returns the account wide Gateway settings, such as
+// TLS decryption, activity logging, the block page, body scanning and
+// anti-virus scanning.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-accounts-get-zero-trust-account-configuration
+func (api *API) GetGatewayConfiguration(ctx context.Context, rc *ResourceContainer) (TeamsConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return TeamsConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return TeamsConfiguration{}, ErrMissingAccountID
+	}
+
+	return api.TeamsAccountConfiguration(ctx, rc.Identifier)
+}
+
+// UpdateGatewayConfiguration changes the account wide Gateway settings that
+// are set in settings, leaving the others as they are. Use
+// TeamsAccountUpdateConfiguration to replace all of the settings.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-accounts-patch-zero-trust-account-configuration
+func (api *API) UpdateGatewayConfiguration(ctx context.Context, rc *ResourceContainer, settings TeamsAccountSettings) (TeamsConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return TeamsConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return TeamsConfiguration{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/gateway/configuration", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, gatewayConfigurationPatchRequest{Settings: settings})
+	if err != nil {
+		return TeamsConfiguration{}, err
+	}
+
+	var teamsConfigResponse TeamsConfigResponse
+	err = json.Unmarshal(res, &teamsConfigResponse)
+	if err != nil {
+		return TeamsConfiguration{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return teamsConfigResponse.Result, nil
+}
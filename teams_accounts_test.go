@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
@@ -307,3 +308,51 @@ func TestTeamsAccountUpdateDeviceConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGatewayConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"settings": {
+				"tls_decrypt": {"enabled": true},
+				"block_page": {"enabled": true, "name": "Example Corp", "footer_text": "Contact IT"}
+			}
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"settings": {
+					"tls_decrypt": {"enabled": true},
+					"activity_log": {"enabled": true},
+					"block_page": {"enabled": true, "name": "Example Corp", "footer_text": "Contact IT"}
+				}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/configuration", handler)
+
+	settings := TeamsAccountSettings{
+		TLSDecrypt: &TeamsTLSDecrypt{Enabled: true},
+		BlockPage:  &TeamsBlockPage{Enabled: BoolPtr(true), Name: "Example Corp", FooterText: "Contact IT"},
+	}
+
+	want := TeamsConfiguration{Settings: settings}
+	want.Settings.ActivityLog = &TeamsActivityLog{Enabled: true}
+
+	actual, err := client.UpdateGatewayConfiguration(context.Background(), AccountIdentifier(testAccountID), settings)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateGatewayConfiguration(context.Background(), ZoneIdentifier(testZoneID), settings)
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// GatewayApplication is an application that Gateway rules can match, such as
+// a specific generative AI service.
+type GatewayApplication struct {
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	ApplicationTypeID int        `json:"application_type_id"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+}
+
+// GatewayAppType is a category of applications that Gateway rules can match,
+// such as "Artificial Intelligence".
+type GatewayAppType struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// gatewayAppTypesEntry is an entry of the app types catalog, which mixes
+// applications, identified by having an application type, with the
+// application types themselves.
+type gatewayAppTypesEntry struct {
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description"`
+	ApplicationTypeID *int       `json:"application_type_id"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+}
+
+type gatewayAppTypesResponse struct {
+	Response
+	Result []gatewayAppTypesEntry `json:"result"`
+}
+
+// ListGatewayApplications returns the applications that Gateway rules can
+// match with the `app.ids` selector.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-gateway-application-and-application-type-mappings-list-application-and-application-type-mappings
+func (api *API) ListGatewayApplications(ctx context.Context, rc *ResourceContainer) ([]GatewayApplication, error) {
+	entries, err := api.listGatewayAppTypes(ctx, rc)
+	if err != nil {
+		return []GatewayApplication{}, err
+	}
+
+	applications := []GatewayApplication{}
+	for _, entry := range entries {
+		if entry.ApplicationTypeID == nil {
+			continue
+		}
+
+		applications = append(applications, GatewayApplication{
+			ID:                entry.ID,
+			Name:              entry.Name,
+			ApplicationTypeID: *entry.ApplicationTypeID,
+			CreatedAt:         entry.CreatedAt,
+		})
+	}
+
+	return applications, nil
+}
+
+// ListGatewayAppTypes returns the application types that Gateway rules can
+// match with the `app.type.ids` selector.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-gateway-application-and-application-type-mappings-list-application-and-application-type-mappings
+func (api *API) ListGatewayAppTypes(ctx context.Context, rc *ResourceContainer) ([]GatewayAppType, error) {
+	entries, err := api.listGatewayAppTypes(ctx, rc)
+	if err != nil {
+		return []GatewayAppType{}, err
+	}
+
+	appTypes := []GatewayAppType{}
+	for _, entry := range entries {
+		if entry.ApplicationTypeID != nil {
+			continue
+		}
+
+		appTypes = append(appTypes, GatewayAppType{
+			ID:          entry.ID,
+			Name:        entry.Name,
+			Description: entry.Description,
+			CreatedAt:   entry.CreatedAt,
+		})
+	}
+
+	return appTypes, nil
+}
+
+func (api *API) listGatewayAppTypes(ctx context.Context, rc *ResourceContainer) ([]gatewayAppTypesEntry, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/gateway/app_types", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r gatewayAppTypesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handleGatewayAppTypes(t *testing.T) {
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/app_types", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": 25,
+					"name": "Artificial Intelligence",
+					"description": "Generative AI and machine learning services",
+					"created_at": "2023-09-20T16:31:21Z"
+				},
+				{
+					"id": 1199,
+					"name": "ChatGPT",
+					"application_type_id": 25,
+					"created_at": "2023-09-20T16:31:21Z"
+				}
+			]
+		}`)
+	})
+}
+
+func TestListGatewayApplications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleGatewayAppTypes(t)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2023-09-20T16:31:21Z")
+	want := []GatewayApplication{{
+		ID:                1199,
+		Name:              "ChatGPT",
+		ApplicationTypeID: 25,
+		CreatedAt:         &createdAt,
+	}}
+
+	actual, err := client.ListGatewayApplications(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListGatewayApplications(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
+
+func TestListGatewayAppTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleGatewayAppTypes(t)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2023-09-20T16:31:21Z")
+	want := []GatewayAppType{{
+		ID:          25,
+		Name:        "Artificial Intelligence",
+		Description: "Generative AI and machine learning services",
+		CreatedAt:   &createdAt,
+	}}
+
+	actual, err := client.ListGatewayAppTypes(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,192 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/cloudflare/cloudflare-go"
+
+var (
+	// pathIdentifierRegex matches path segments that are resource identifiers:
+	// 32 character hex tags, UUIDs and numeric IDs.
+	pathIdentifierRegex = regexp.MustCompile(`^(?:[0-9a-fA-F]{32}|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]+)$`)
+
+	// pathCollectionSegments are collections whose next segment is an
+	// identifier or a user chosen name, such as a zone name, Worker script
+	// name or R2 bucket name, even when it doesn't look like an identifier.
+	// Collections nested in another collection are kept as they are.
+	pathCollectionSegments = map[string]bool{
+		"accounts": true, "active_sessions": true, "address_maps": true,
+		"addresses": true, "apps": true, "asn": true, "bindings": true,
+		"bookmarks": true, "buckets": true, "bulk_operations": true,
+		"certificate_packs": true, "certificates": true, "cfd_tunnel": true,
+		"ciphers": true, "client_certificates": true, "configs": true,
+		"consumers": true, "custom": true, "custom_certificates": true,
+		"custom_hostnames": true, "custom_ns": true, "custom_pages": true,
+		"database": true, "datasets": true, "deployments": true, "devices": true,
+		"dns_firewall": true, "dns_records": true, "domains": true,
+		"environments": true, "events": true, "filters": true, "gateways": true,
+		"gre_tunnels": true, "groups": true, "healthchecks": true,
+		"hostnames": true, "identity_providers": true, "images": true,
+		"indexes": true, "integration": true, "ip": true, "ipsec_tunnels": true,
+		"items": true, "jobs": true, "keyless_certificates": true, "keys": true,
+		"lists": true, "live_inputs": true, "load_balancers": true,
+		"locations": true, "lockdowns": true, "members": true, "monitors": true,
+		"mtls_certificates": true, "namespaces": true, "network": true,
+		"networks": true, "operations": true, "origin_tls_client_auth": true,
+		"outputs": true, "overrides": true, "packages": true, "pagerules": true,
+		"pages": true, "permission_groups": true, "policies": true, "policy": true,
+		"pools": true, "posture": true, "prefixes": true, "preview": true,
+		"primaries": true, "profiles": true, "projects": true,
+		"proxy_endpoints": true, "queues": true, "r2": true, "rate_limits": true,
+		"regional_hostnames": true, "roles": true, "routes": true, "rule": true,
+		"rules": true, "rulesets": true, "schedule": true, "scripts": true,
+		"secrets": true, "service_tokens": true, "services": true,
+		"site_info": true, "snippets": true, "stream": true, "subscriptions": true,
+		"tags": true, "tails": true, "tests": true, "tokens": true, "tsigs": true,
+		"turn_keys": true, "ua_rules": true, "user_schemas": true, "users": true,
+		"v1": true, "v2": true, "values": true, "verification": true,
+		"versions": true, "virtual_networks": true, "waiting_rooms": true,
+		"webhooks": true, "widgets": true, "zones": true,
+	}
+
+	// pathRemainderSegments are collections whose identifiers may contain
+	// slashes, such as Workers AI model names, so that the rest of the path
+	// is an identifier.
+	pathRemainderSegments = map[string]bool{
+		"run": true,
+	}
+
+	// pathStaticSegments are actions on a collection that aren't identifiers.
+	pathStaticSegments = map[string]bool{
+		"batch":  true,
+		"bulk":   true,
+		"export": true,
+		"import": true,
+		"phases": true,
+		"scan":   true,
+	}
+)
+
+// WithTracing creates an OpenTelemetry span for every API request using the
+// provided TracerProvider. Spans are named after the HTTP method and the
+// path template of the endpoint (e.g. `cloudflare.GET /zones/{id}/dns_records`)
+// to keep their cardinality low.
+func WithTracing(tp trace.TracerProvider) Option {
+	return func(api *API) error {
+		if tp == nil {
+			return errors.New("tracer provider cannot be nil")
+		}
+		api.tracer = tp.Tracer(tracerName)
+		return nil
+	}
+}
+
+// startSpan starts a span for the request, returning the context to use for
+// the request.
+func (api *API) startSpan(ctx context.Context, method, uri string) (context.Context, trace.Span) {
+	template := pathTemplate(uri)
+
+	return api.tracer.Start(ctx, "cloudflare."+method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", method),
+			attribute.String("http.route", template),
+		),
+	)
+}
+
+// endSpan records the outcome of a request on the span and ends it.
+func endSpan(span trace.Span, resp *http.Response, err error) {
+	defer span.End()
+
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+		if rayID := resp.Header.Get("cf-ray"); rayID != "" {
+			span.SetAttributes(attribute.String("cf.ray", rayID))
+		}
+	}
+
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	if cfErr := cloudflareErrorFrom(err); cfErr != nil {
+		span.SetAttributes(attribute.Int("http.status_code", cfErr.StatusCode))
+		if cfErr.RayID != "" {
+			span.SetAttributes(attribute.String("cf.ray", cfErr.RayID))
+		}
+		if len(cfErr.Errors) > 0 {
+			span.SetAttributes(attribute.Int("cf.error_code", cfErr.Errors[0].Code))
+		}
+	}
+}
+
+// cloudflareErrorFrom returns the underlying *Error of the API error types.
+func cloudflareErrorFrom(err error) *Error {
+	var requestErr *RequestError
+	var ratelimitErr *RatelimitError
+	var serviceErr *ServiceError
+	var authenticationErr *AuthenticationError
+	var authorizationErr *AuthorizationError
+	var notFoundErr *NotFoundError
+
+	switch {
+	case errors.As(err, &requestErr):
+		return requestErr.cloudflareError
+	case errors.As(err, &ratelimitErr):
+		return ratelimitErr.cloudflareError
+	case errors.As(err, &serviceErr):
+		return serviceErr.cloudflareError
+	case errors.As(err, &authenticationErr):
+		return authenticationErr.cloudflareError
+	case errors.As(err, &authorizationErr):
+		return authorizationErr.cloudflareError
+	case errors.As(err, &notFoundErr):
+		return notFoundErr.cloudflareError
+	}
+
+	return nil
+}
+
+// pathTemplate replaces the identifiers and resource names in a request URI
+// with `{id}` and drops the query string so that requests to the same
+// endpoint share a span name.
+func pathTemplate(uri string) string {
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		uri = uri[:i]
+	}
+
+	segments := strings.Split(uri, "/")
+	template := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		previous := ""
+		if i > 0 {
+			previous = segments[i-1]
+		}
+
+		switch {
+		case segment == "" || pathStaticSegments[segment] || pathCollectionSegments[segment]:
+			template = append(template, segment)
+		case pathRemainderSegments[previous]:
+			return strings.Join(append(template, "{id}"), "/")
+		case pathIdentifierRegex.MatchString(segment) || pathCollectionSegments[previous]:
+			template = append(template, "{id}")
+		default:
+			template = append(template, segment)
+		}
+	}
+
+	return strings.Join(template, "/")
+}
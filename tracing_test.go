@@ -0,0 +1,159 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recordedSpan struct {
+	trace.Span
+	name       string
+	attributes map[attribute.Key]attribute.Value
+	status     codes.Code
+	ended      bool
+}
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, attr := range kv {
+		s.attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, description string) {
+	s.status = code
+}
+
+func (s *recordedSpan) End(options ...trace.SpanEndOption) {
+	s.ended = true
+}
+
+type recordingTracerProvider struct {
+	trace.TracerProvider
+	spans []*recordedSpan
+}
+
+func (tp *recordingTracerProvider) Tracer(name string, options ...trace.TracerOption) trace.Tracer {
+	return tp
+}
+
+func (tp *recordingTracerProvider) Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	_, noop := trace.NewNoopTracerProvider().Tracer("").Start(ctx, name)
+	span := &recordedSpan{Span: noop, name: name, attributes: map[attribute.Key]attribute.Value{}}
+	config := trace.NewSpanStartConfig(options...)
+	span.SetAttributes(config.Attributes()...)
+	tp.spans = append(tp.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func TestWithTracing(t *testing.T) {
+	tp := &recordingTracerProvider{}
+	setup(WithTracing(tp))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("cf-ray", "7d8a9b0c1d2e3f40-LHR")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59"}}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/00000000000000000000000000000000", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("cf-ray", "7d8a9b0c1d2e3f41-LHR")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 81044, "message": "Record does not exist."}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.GetDNSRecord(context.Background(), ZoneIdentifier(testZoneID), "372e67954025e0ba6aaa6d586b9e0b59")
+	assert.NoError(t, err)
+
+	_, err = client.GetDNSRecord(context.Background(), ZoneIdentifier(testZoneID), "00000000000000000000000000000000")
+	assert.Error(t, err)
+
+	if assert.Len(t, tp.spans, 2) {
+		for _, span := range tp.spans {
+			assert.Equal(t, "cloudflare.GET /zones/{id}/dns_records/{id}", span.name)
+			assert.Equal(t, "/zones/{id}/dns_records/{id}", span.attributes["http.route"].AsString())
+			assert.True(t, span.ended)
+		}
+
+		assert.Equal(t, int64(200), tp.spans[0].attributes["http.status_code"].AsInt64())
+		assert.Equal(t, "7d8a9b0c1d2e3f40-LHR", tp.spans[0].attributes["cf.ray"].AsString())
+		assert.Equal(t, codes.Unset, tp.spans[0].status)
+
+		assert.Equal(t, int64(404), tp.spans[1].attributes["http.status_code"].AsInt64())
+		assert.Equal(t, "7d8a9b0c1d2e3f41-LHR", tp.spans[1].attributes["cf.ray"].AsString())
+		assert.Equal(t, int64(81044), tp.spans[1].attributes["cf.error_code"].AsInt64())
+		assert.Equal(t, codes.Error, tp.spans[1].status)
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records?page=1":                    "/zones/{id}/dns_records",
+		"/accounts/01a7362d577a6c3019a474fd6f485823/workers/scripts/my-worker":          "/accounts/{id}/workers/scripts/{id}",
+		"/zones/example.com/settings/ssl":                                               "/zones/{id}/settings/ssl",
+		"/user/tokens/f267e341f3dd4697bd3b9f71dd96247f":                                 "/user/tokens/{id}",
+		"/accounts/abc/access/apps/f174e90a-fafe-4643-bbbc-4a0ed4fc8415/policies/12345": "/accounts/{id}/access/apps/{id}/policies/{id}",
+		"/accounts/abc/r2/buckets/my-bucket":                                            "/accounts/{id}/r2/buckets/{id}",
+		"/accounts/abc/storage/kv/namespaces/ns/values/session%2Fabc":                   "/accounts/{id}/storage/kv/namespaces/{id}/values/{id}",
+		"/accounts/abc/vectorize/v2/indexes/docs/query":                                 "/accounts/{id}/vectorize/v2/indexes/{id}/query",
+		"/accounts/abc/ai/run/@cf/meta/llama-3-8b-instruct":                             "/accounts/{id}/ai/run/{id}",
+		"/accounts/abc/pages/projects/my-site/deployments":                              "/accounts/{id}/pages/projects/{id}/deployments",
+		"/zones/example.com/dns_records/export":                                         "/zones/{id}/dns_records/export",
+		"/zones/example.com/speed_api/pages/example.com%2Fblog/tests":                   "/zones/{id}/speed_api/pages/{id}/tests",
+	}
+
+	for uri, want := range tests {
+		assert.Equal(t, want, pathTemplate(uri), uri)
+	}
+}
+
+func TestWithTracing_StreamedRequests(t *testing.T) {
+	tp := &recordingTracerProvider{}
+	setup(WithTracing(tp), Headers(http.Header{"X-Team": []string{"infra"}}))
+	defer teardown()
+
+	var requests []http.Header
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/meta/llama-3-8b-instruct", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header)
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("cf-ray", "7d8a9b0c1d2e3f40-LHR")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"response": "hi"}}`)
+	})
+
+	ctx := WithIdempotencyKey(context.Background(), "generate-greeting")
+	params := RunWorkersAIModelParams{
+		Model: "@cf/meta/llama-3-8b-instruct",
+		Input: map[string]string{"prompt": "say hi"},
+	}
+
+	err := client.RunWorkersAIModel(ctx, AccountIdentifier(testAccountID), params, nil)
+	assert.NoError(t, err)
+
+	params.Stream = io.Discard
+	err = client.RunWorkersAIModel(ctx, AccountIdentifier(testAccountID), params, nil)
+	assert.NoError(t, err)
+
+	if assert.Len(t, requests, 2) {
+		for _, name := range []string{"X-Team", "X-Auth-Key", IdempotencyKeyHeader} {
+			assert.Equal(t, requests[0].Get(name), requests[1].Get(name), name)
+		}
+		assert.NotEmpty(t, requests[1].Get(IdempotencyKeyHeader))
+	}
+
+	if assert.Len(t, tp.spans, 2) {
+		for _, span := range tp.spans {
+			assert.Equal(t, "cloudflare.POST /accounts/{id}/ai/run/{id}", span.name)
+			assert.Equal(t, int64(200), span.attributes["http.status_code"].AsInt64())
+			assert.Equal(t, "7d8a9b0c1d2e3f40-LHR", span.attributes["cf.ray"].AsString())
+			assert.True(t, span.ended)
+		}
+	}
+}
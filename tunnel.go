@@ -231,6 +231,10 @@ type TunnelListParams struct {
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnels
 func (api *API) ListTunnels(ctx context.Context, rc *ResourceContainer, params TunnelListParams) ([]Tunnel, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []Tunnel{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []Tunnel{}, &ResultInfo{}, ErrMissingAccountID
 	}
@@ -277,12 +281,16 @@ func (api *API) ListTunnels(ctx context.Context, rc *ResourceContainer, params T
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-cloudflare-tunnel
 func (api *API) GetTunnel(ctx context.Context, rc *ResourceContainer, tunnelID string) (Tunnel, error) {
+	if rc.Level != AccountRouteLevel {
+		return Tunnel{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Tunnel{}, ErrMissingAccountID
 	}
 
 	if tunnelID == "" {
-		return Tunnel{}, errors.New("missing tunnel ID")
+		return Tunnel{}, ErrMissingTunnelID
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", rc.Identifier, tunnelID)
@@ -304,6 +312,10 @@ func (api *API) GetTunnel(ctx context.Context, rc *ResourceContainer, tunnelID s
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-create-cloudflare-tunnel
 func (api *API) CreateTunnel(ctx context.Context, rc *ResourceContainer, params TunnelCreateParams) (Tunnel, error) {
+	if rc.Level != AccountRouteLevel {
+		return Tunnel{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Tunnel{}, ErrMissingAccountID
 	}
@@ -336,6 +348,10 @@ func (api *API) CreateTunnel(ctx context.Context, rc *ResourceContainer, params
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-update-cloudflare-tunnel
 func (api *API) UpdateTunnel(ctx context.Context, rc *ResourceContainer, params TunnelUpdateParams) (Tunnel, error) {
+	if rc.Level != AccountRouteLevel {
+		return Tunnel{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return Tunnel{}, ErrMissingAccountID
 	}
@@ -370,6 +386,10 @@ func (api *API) UpdateTunnel(ctx context.Context, rc *ResourceContainer, params
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-properties
 func (api *API) UpdateTunnelConfiguration(ctx context.Context, rc *ResourceContainer, params TunnelConfigurationParams) (TunnelConfigurationResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return TunnelConfigurationResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return TunnelConfigurationResult{}, ErrMissingAccountID
 	}
@@ -403,6 +423,10 @@ func (api *API) UpdateTunnelConfiguration(ctx context.Context, rc *ResourceConta
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-properties
 func (api *API) GetTunnelConfiguration(ctx context.Context, rc *ResourceContainer, tunnelID string) (TunnelConfigurationResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return TunnelConfigurationResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return TunnelConfigurationResult{}, ErrMissingAccountID
 	}
@@ -436,6 +460,10 @@ func (api *API) GetTunnelConfiguration(ctx context.Context, rc *ResourceContaine
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnel-connections
 func (api *API) ListTunnelConnections(ctx context.Context, rc *ResourceContainer, tunnelID string) ([]Connection, error) {
+	if rc.Level != AccountRouteLevel {
+		return []Connection{}, ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return []Connection{}, ErrMissingAccountID
 	}
@@ -463,6 +491,18 @@ func (api *API) ListTunnelConnections(ctx context.Context, rc *ResourceContainer
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-delete-cloudflare-tunnel
 func (api *API) DeleteTunnel(ctx context.Context, rc *ResourceContainer, tunnelID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if tunnelID == "" {
+		return ErrMissingTunnelID
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", rc.Identifier, tunnelID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
@@ -483,12 +523,16 @@ func (api *API) DeleteTunnel(ctx context.Context, rc *ResourceContainer, tunnelI
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-clean-up-cloudflare-tunnel-connections
 func (api *API) CleanupTunnelConnections(ctx context.Context, rc *ResourceContainer, tunnelID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
 
 	if tunnelID == "" {
-		return errors.New("missing tunnel ID")
+		return ErrMissingTunnelID
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/connections", rc.Identifier, tunnelID)
@@ -511,12 +555,16 @@ func (api *API) CleanupTunnelConnections(ctx context.Context, rc *ResourceContai
 //
 // API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-cloudflare-tunnel-token
 func (api *API) GetTunnelToken(ctx context.Context, rc *ResourceContainer, tunnelID string) (string, error) {
+	if rc.Level != AccountRouteLevel {
+		return "", ErrRequiredAccountLevelResourceContainer
+	}
+
 	if rc.Identifier == "" {
 		return "", ErrMissingAccountID
 	}
 
 	if tunnelID == "" {
-		return "", errors.New("missing tunnel ID")
+		return "", ErrMissingTunnelID
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/token", rc.Identifier, tunnelID)
@@ -372,7 +372,10 @@ func TestDeleteTunnel(t *testing.T) {
 
 	mux.HandleFunc(fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", testAccountID, testTunnelID), handler)
 
-	err := client.DeleteTunnel(context.Background(), AccountIdentifier(testAccountID), testTunnelID)
+	err := client.DeleteTunnel(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingTunnelID)
+
+	err = client.DeleteTunnel(context.Background(), AccountIdentifier(testAccountID), testTunnelID)
 	assert.NoError(t, err)
 }
 
@@ -404,6 +407,9 @@ func TestTunnelToken(t *testing.T) {
 
 	mux.HandleFunc(fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/token", testAccountID, testTunnelID), handler)
 
+	_, err := client.GetTunnelToken(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingTunnelID)
+
 	token, err := client.GetTunnelToken(context.Background(), AccountIdentifier(testAccountID), testTunnelID)
 	assert.NoError(t, err)
 	assert.Equal(t, "ZHNraGdhc2RraGFza2hqZGFza2poZGFza2poYXNrZGpoYWtzamRoa2FzZGpoa2FzamRoa2Rhc2po\na2FzamRoa2FqCg==", token)
@@ -1,16 +1,33 @@
 package cloudflare
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/goccy/go-json"
 )
 
-var ErrMissingSiteKey = errors.New("required site key missing")
+var (
+	ErrMissingSiteKey           = errors.New("required site key missing")
+	ErrMissingTurnstileSecret   = errors.New("required Turnstile secret missing")
+	ErrMissingTurnstileResponse = errors.New("required Turnstile response token missing")
+)
+
+const (
+	// turnstileSiteVerifyURL is the endpoint used to validate Turnstile
+	// tokens. It is not part of the API and is authenticated with the
+	// widget's secret.
+	turnstileSiteVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+	// turnstileVerifyMaxSize limits how much of a siteverify response is
+	// read.
+	turnstileVerifyMaxSize = 1 << 20
+)
 
 type TurnstileWidget struct {
 	SiteKey      string     `json:"sitekey,omitempty"`
@@ -243,3 +260,69 @@ func (api *API) DeleteTurnstileWidget(ctx context.Context, rc *ResourceContainer
 
 	return nil
 }
+
+// TurnstileVerifyParams are the parameters for validating a Turnstile token.
+type TurnstileVerifyParams struct {
+	// Secret is the secret key of the widget that issued the token.
+	Secret string `json:"secret"`
+	// Response is the token produced by the widget on the client.
+	Response string `json:"response"`
+	// RemoteIP is the IP address of the visitor, if known.
+	RemoteIP string `json:"remoteip,omitempty"`
+	// IdempotencyKey allows validating the same token again, for example
+	// when retrying, without it being reported as a duplicate.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// TurnstileVerifyResponse is the outcome of validating a Turnstile token.
+type TurnstileVerifyResponse struct {
+	Success     bool       `json:"success"`
+	ChallengeTS *time.Time `json:"challenge_ts,omitempty"`
+	Hostname    string     `json:"hostname"`
+	ErrorCodes  []string   `json:"error-codes"`
+	Action      string     `json:"action"`
+	CData       string     `json:"cdata"`
+}
+
+// VerifyTurnstileToken validates a token produced by a Turnstile widget.
+// A token that fails validation is not an error; Success is false and
+// ErrorCodes describes why.
+//
+// API reference: https://developers.cloudflare.com/turnstile/get-started/server-side-validation/
+func (api *API) VerifyTurnstileToken(ctx context.Context, params TurnstileVerifyParams) (TurnstileVerifyResponse, error) {
+	if params.Secret == "" {
+		return TurnstileVerifyResponse{}, ErrMissingTurnstileSecret
+	}
+
+	if params.Response == "" {
+		return TurnstileVerifyResponse{}, ErrMissingTurnstileResponse
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("error marshalling params to JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, turnstileSiteVerifyURL, bytes.NewReader(body))
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := api.send(req)
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TurnstileVerifyResponse{}, fmt.Errorf("verifying Turnstile token failed: %s", resp.Status)
+	}
+
+	var r TurnstileVerifyResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, turnstileVerifyMaxSize)).Decode(&r); err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
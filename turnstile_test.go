@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -332,3 +333,71 @@ func TestTurnstileWidgets_Delete(t *testing.T) {
 	err = client.DeleteTurnstileWidget(context.Background(), AccountIdentifier(testAccountID), testTurnstileWidgetSiteKey)
 	assert.NoError(t, err)
 }
+
+func TestVerifyTurnstileToken(t *testing.T) {
+	setup(WithDoer(testServerDoer("challenges.cloudflare.com")))
+	defer teardown()
+
+	mux.HandleFunc("/turnstile/v0/siteverify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Empty(t, r.Header.Get("Authorization"), "the API credentials must not be sent")
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("content-type", "application/json")
+		if string(body) == `{"secret":"0x4AAF00AAAABn0R22HWm098HVBjhdsYUc","response":"expired"}` {
+			fmt.Fprint(w, `{"success": false, "error-codes": ["timeout-or-duplicate"], "messages": []}`)
+			return
+		}
+
+		assert.JSONEq(t, `{
+			"secret": "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+			"response": "XXXX.DUMMY.TOKEN",
+			"remoteip": "203.0.113.7",
+			"idempotency_key": "0f1b6e2a-8f4c-4b7a-9b2e-1f0d3c5a7e9b"
+		}`, string(body))
+		fmt.Fprint(w, `{
+			"success": true,
+			"challenge_ts": "2024-10-01T09:30:00.000Z",
+			"hostname": "example.com",
+			"error-codes": [],
+			"action": "login",
+			"cdata": "sessionid-123456789",
+			"metadata": {"ephemeral_id": "x:9f78e0ed210960d7693b167e"}
+		}`)
+	})
+
+	_, err := client.VerifyTurnstileToken(context.Background(), TurnstileVerifyParams{Response: "XXXX.DUMMY.TOKEN"})
+	assert.ErrorIs(t, err, ErrMissingTurnstileSecret)
+
+	_, err = client.VerifyTurnstileToken(context.Background(), TurnstileVerifyParams{Secret: "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc"})
+	assert.ErrorIs(t, err, ErrMissingTurnstileResponse)
+
+	challengeTS := time.Date(2024, time.October, 1, 9, 30, 0, 0, time.UTC)
+	want := TurnstileVerifyResponse{
+		Success:     true,
+		ChallengeTS: &challengeTS,
+		Hostname:    "example.com",
+		ErrorCodes:  []string{},
+		Action:      "login",
+		CData:       "sessionid-123456789",
+	}
+
+	actual, err := client.VerifyTurnstileToken(context.Background(), TurnstileVerifyParams{
+		Secret:         "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+		Response:       "XXXX.DUMMY.TOKEN",
+		RemoteIP:       "203.0.113.7",
+		IdempotencyKey: "0f1b6e2a-8f4c-4b7a-9b2e-1f0d3c5a7e9b",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	actual, err = client.VerifyTurnstileToken(context.Background(), TurnstileVerifyParams{
+		Secret:   "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+		Response: "expired",
+	})
+	if assert.NoError(t, err) {
+		assert.False(t, actual.Success)
+		assert.Equal(t, []string{"timeout-or-duplicate"}, actual.ErrorCodes)
+	}
+}
@@ -2,12 +2,32 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/goccy/go-json"
 )
 
+var (
+	ErrInvalidURLNormalizationType  = errors.New("URL normalization type must be cloudflare or rfc3986")
+	ErrInvalidURLNormalizationScope = errors.New("URL normalization scope must be incoming or both")
+)
+
+const (
+	// URLNormalizationTypeCloudflare applies RFC 3986 normalization plus
+	// Cloudflare's additional transformations, such as converting
+	// backslashes to forward slashes.
+	URLNormalizationTypeCloudflare = "cloudflare"
+	// URLNormalizationTypeRFC3986 applies only RFC 3986 normalization.
+	URLNormalizationTypeRFC3986 = "rfc3986"
+
+	// URLNormalizationScopeIncoming normalizes URLs for rule evaluation only.
+	URLNormalizationScopeIncoming = "incoming"
+	// URLNormalizationScopeBoth also sends the normalized URL to the origin.
+	URLNormalizationScopeBoth = "both"
+)
+
 type URLNormalizationSettings struct {
 	Type  string `json:"type"`
 	Scope string `json:"scope"`
@@ -24,7 +44,25 @@ type URLNormalizationSettingsUpdateParams struct {
 }
 
 // URLNormalizationSettings API reference: https://api.cloudflare.com/#url-normalization-get-url-normalization-settings
+//
+// Deprecated: Use `GetURLNormalizationSettings` instead.
 func (api *API) URLNormalizationSettings(ctx context.Context, rc *ResourceContainer) (URLNormalizationSettings, error) {
+	return api.GetURLNormalizationSettings(ctx, rc)
+}
+
+// GetURLNormalizationSettings returns how URLs of requests to the zone are
+// normalized before rules are evaluated.
+//
+// API reference: https://api.cloudflare.com/#url-normalization-get-url-normalization-settings
+func (api *API) GetURLNormalizationSettings(ctx context.Context, rc *ResourceContainer) (URLNormalizationSettings, error) {
+	if rc.Level != ZoneRouteLevel {
+		return URLNormalizationSettings{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return URLNormalizationSettings{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/url_normalization", rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
@@ -43,6 +81,22 @@ func (api *API) URLNormalizationSettings(ctx context.Context, rc *ResourceContai
 
 // UpdateURLNormalizationSettings https://api.cloudflare.com/#url-normalization-update-url-normalization-settings
 func (api *API) UpdateURLNormalizationSettings(ctx context.Context, rc *ResourceContainer, params URLNormalizationSettingsUpdateParams) (URLNormalizationSettings, error) {
+	if rc.Level != ZoneRouteLevel {
+		return URLNormalizationSettings{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return URLNormalizationSettings{}, ErrMissingZoneID
+	}
+
+	if params.Type != URLNormalizationTypeCloudflare && params.Type != URLNormalizationTypeRFC3986 {
+		return URLNormalizationSettings{}, ErrInvalidURLNormalizationType
+	}
+
+	if params.Scope != URLNormalizationScopeIncoming && params.Scope != URLNormalizationScopeBoth {
+		return URLNormalizationSettings{}, ErrInvalidURLNormalizationScope
+	}
+
 	uri := fmt.Sprintf("/zones/%s/url_normalization", rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
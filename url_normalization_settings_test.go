@@ -41,6 +41,40 @@ func TestURLNormalizationSettings(t *testing.T) {
 	}
 }
 
+func TestGetURLNormalizationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"type": "rfc3986",
+				"scope": "both"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/url_normalization", handler)
+
+	want := URLNormalizationSettings{
+		Type:  URLNormalizationTypeRFC3986,
+		Scope: URLNormalizationScopeBoth,
+	}
+
+	_, err := client.GetURLNormalizationSettings(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	got, err := client.GetURLNormalizationSettings(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, got)
+	}
+}
+
 func TestUpdateURLNormalizationSettings(t *testing.T) {
 	setup()
 	defer teardown()
@@ -84,3 +118,23 @@ func TestUpdateURLNormalizationSettings(t *testing.T) {
 		assert.Equal(t, want, got)
 	}
 }
+
+func TestUpdateURLNormalizationSettings_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateURLNormalizationSettings(context.Background(), AccountIdentifier(testAccountID), URLNormalizationSettingsUpdateParams{})
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	_, err = client.UpdateURLNormalizationSettings(context.Background(), ZoneIdentifier(testZoneID), URLNormalizationSettingsUpdateParams{
+		Type:  "strict",
+		Scope: URLNormalizationScopeIncoming,
+	})
+	assert.ErrorIs(t, err, ErrInvalidURLNormalizationType)
+
+	_, err = client.UpdateURLNormalizationSettings(context.Background(), ZoneIdentifier(testZoneID), URLNormalizationSettingsUpdateParams{
+		Type:  URLNormalizationTypeRFC3986,
+		Scope: "outgoing",
+	})
+	assert.ErrorIs(t, err, ErrInvalidURLNormalizationScope)
+}
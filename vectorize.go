@@ -0,0 +1,360 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingVectorizeIndexName  = errors.New("required Vectorize index name missing")
+	ErrMissingVectorizeDimensions = errors.New("required Vectorize index dimensions missing")
+	ErrInvalidVectorizeMetric     = errors.New("invalid Vectorize distance metric, must be one of: cosine, euclidean, dot-product")
+	ErrMissingVectorizeVectors    = errors.New("at least one vector is required")
+	ErrMissingVectorizeVectorIDs  = errors.New("at least one vector ID is required")
+)
+
+// VectorizeMetric is the distance metric used to compare vectors in an
+// index.
+type VectorizeMetric string
+
+const (
+	VectorizeMetricCosine     VectorizeMetric = "cosine"
+	VectorizeMetricEuclidean  VectorizeMetric = "euclidean"
+	VectorizeMetricDotProduct VectorizeMetric = "dot-product"
+)
+
+// VectorizeReturnMetadata controls which metadata is returned with query
+// matches.
+type VectorizeReturnMetadata string
+
+const (
+	VectorizeReturnMetadataNone    VectorizeReturnMetadata = "none"
+	VectorizeReturnMetadataIndexed VectorizeReturnMetadata = "indexed"
+	VectorizeReturnMetadataAll     VectorizeReturnMetadata = "all"
+)
+
+// VectorizeIndexConfig is the immutable configuration of an index.
+type VectorizeIndexConfig struct {
+	Dimensions int             `json:"dimensions"`
+	Metric     VectorizeMetric `json:"metric"`
+}
+
+// VectorizeIndex is a Vectorize vector database index.
+type VectorizeIndex struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Config      VectorizeIndexConfig `json:"config"`
+	CreatedOn   *time.Time           `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time           `json:"modified_on,omitempty"`
+}
+
+type VectorizeIndexParams struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Config      VectorizeIndexConfig `json:"config"`
+}
+
+// VectorizeVector is a vector stored in an index. Values must have as many
+// dimensions as the index.
+type VectorizeVector struct {
+	ID        string                 `json:"id"`
+	Values    []float64              `json:"values"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// VectorizeMutation identifies an asynchronous change of the vectors in an
+// index.
+type VectorizeMutation struct {
+	MutationID string `json:"mutationId"`
+}
+
+type VectorizeQueryParams struct {
+	Vector         []float64               `json:"vector"`
+	TopK           int                     `json:"topK,omitempty"`
+	ReturnValues   bool                    `json:"returnValues,omitempty"`
+	ReturnMetadata VectorizeReturnMetadata `json:"returnMetadata,omitempty"`
+	Namespace      string                  `json:"namespace,omitempty"`
+	Filter         map[string]interface{}  `json:"filter,omitempty"`
+}
+
+// VectorizeMatch is a vector matching a query, ordered by Score.
+type VectorizeMatch struct {
+	ID        string                 `json:"id"`
+	Score     float64                `json:"score"`
+	Values    []float64              `json:"values,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type VectorizeIndexResponse struct {
+	Result VectorizeIndex `json:"result"`
+	Response
+}
+
+type ListVectorizeIndexesResponse struct {
+	Result []VectorizeIndex `json:"result"`
+	Response
+}
+
+type VectorizeMutationResponse struct {
+	Result VectorizeMutation `json:"result"`
+	Response
+}
+
+type QueryVectorizeIndexResponse struct {
+	Result struct {
+		Count   int              `json:"count"`
+		Matches []VectorizeMatch `json:"matches"`
+	} `json:"result"`
+	Response
+}
+
+type vectorizeDeleteByIDsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// ListVectorizeIndexes returns the Vectorize indexes of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-list-vectorize-indexes
+func (api *API) ListVectorizeIndexes(ctx context.Context, rc *ResourceContainer) ([]VectorizeIndex, error) {
+	if rc.Level != AccountRouteLevel {
+		return []VectorizeIndex{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []VectorizeIndex{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []VectorizeIndex{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r ListVectorizeIndexesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []VectorizeIndex{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// CreateVectorizeIndex creates a Vectorize index. The dimensions and metric
+// cannot be changed once the index exists.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-create-vectorize-index
+func (api *API) CreateVectorizeIndex(ctx context.Context, rc *ResourceContainer, params VectorizeIndexParams) (VectorizeIndex, error) {
+	if rc.Level != AccountRouteLevel {
+		return VectorizeIndex{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return VectorizeIndex{}, ErrMissingAccountID
+	}
+
+	if params.Name == "" {
+		return VectorizeIndex{}, ErrMissingVectorizeIndexName
+	}
+
+	if params.Config.Dimensions < 1 {
+		return VectorizeIndex{}, ErrMissingVectorizeDimensions
+	}
+
+	switch params.Config.Metric {
+	case VectorizeMetricCosine, VectorizeMetricEuclidean, VectorizeMetricDotProduct:
+	default:
+		return VectorizeIndex{}, ErrInvalidVectorizeMetric
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return VectorizeIndex{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r VectorizeIndexResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return VectorizeIndex{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// GetVectorizeIndex returns a single Vectorize index.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-get-vectorize-index
+func (api *API) GetVectorizeIndex(ctx context.Context, rc *ResourceContainer, indexName string) (VectorizeIndex, error) {
+	if rc.Level != AccountRouteLevel {
+		return VectorizeIndex{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return VectorizeIndex{}, ErrMissingAccountID
+	}
+
+	if indexName == "" {
+		return VectorizeIndex{}, ErrMissingVectorizeIndexName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s", rc.Identifier, indexName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return VectorizeIndex{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r VectorizeIndexResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return VectorizeIndex{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// DeleteVectorizeIndex deletes a Vectorize index and all of its vectors.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-delete-vectorize-index
+func (api *API) DeleteVectorizeIndex(ctx context.Context, rc *ResourceContainer, indexName string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if indexName == "" {
+		return ErrMissingVectorizeIndexName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s", rc.Identifier, indexName)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return nil
+}
+
+// UpsertVectorizeVectors inserts vectors into an index, replacing vectors
+// with the same ID. The vectors are sent as newline delimited JSON and
+// applied asynchronously; the returned mutation identifies the change.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-upsert-vector
+func (api *API) UpsertVectorizeVectors(ctx context.Context, rc *ResourceContainer, indexName string, vectors []VectorizeVector) (VectorizeMutation, error) {
+	if rc.Level != AccountRouteLevel {
+		return VectorizeMutation{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return VectorizeMutation{}, ErrMissingAccountID
+	}
+
+	if indexName == "" {
+		return VectorizeMutation{}, ErrMissingVectorizeIndexName
+	}
+
+	if len(vectors) == 0 {
+		return VectorizeMutation{}, ErrMissingVectorizeVectors
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, v := range vectors {
+		if err := enc.Encode(v); err != nil {
+			return VectorizeMutation{}, fmt.Errorf("error marshalling vector %q: %w", v.ID, err)
+		}
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", "application/x-ndjson")
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s/upsert", rc.Identifier, indexName)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, body.Bytes(), headers)
+	if err != nil {
+		return VectorizeMutation{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalVectorizeMutation(res)
+}
+
+// QueryVectorizeIndex returns the vectors of an index closest to the query
+// vector.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-query-vector
+func (api *API) QueryVectorizeIndex(ctx context.Context, rc *ResourceContainer, indexName string, params VectorizeQueryParams) ([]VectorizeMatch, error) {
+	if rc.Level != AccountRouteLevel {
+		return []VectorizeMatch{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []VectorizeMatch{}, ErrMissingAccountID
+	}
+
+	if indexName == "" {
+		return []VectorizeMatch{}, ErrMissingVectorizeIndexName
+	}
+
+	if len(params.Vector) == 0 {
+		return []VectorizeMatch{}, ErrMissingVectorizeVectors
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s/query", rc.Identifier, indexName)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return []VectorizeMatch{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	var r QueryVectorizeIndexResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []VectorizeMatch{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result.Matches, nil
+}
+
+// DeleteVectorizeVectors deletes vectors from an index by their IDs. The
+// vectors are removed asynchronously; the returned mutation identifies the
+// change.
+//
+// API reference: https://developers.cloudflare.com/api/operations/vectorize-delete-vectors-by-id
+func (api *API) DeleteVectorizeVectors(ctx context.Context, rc *ResourceContainer, indexName string, ids []string) (VectorizeMutation, error) {
+	if rc.Level != AccountRouteLevel {
+		return VectorizeMutation{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return VectorizeMutation{}, ErrMissingAccountID
+	}
+
+	if indexName == "" {
+		return VectorizeMutation{}, ErrMissingVectorizeIndexName
+	}
+
+	if len(ids) == 0 {
+		return VectorizeMutation{}, ErrMissingVectorizeVectorIDs
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s/delete_by_ids", rc.Identifier, indexName)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, vectorizeDeleteByIDsRequest{IDs: ids})
+	if err != nil {
+		return VectorizeMutation{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+	}
+
+	return unmarshalVectorizeMutation(res)
+}
+
+func unmarshalVectorizeMutation(res []byte) (VectorizeMutation, error) {
+	var r VectorizeMutationResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return VectorizeMutation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testVectorizeIndexName = "docs-search"
+
+func TestCreateVectorizeIndex(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/vectorize/v2/indexes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "docs-search", "config": {"dimensions": 768, "metric": "cosine"}}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "name": "docs-search",
+    "config": {"dimensions": 768, "metric": "cosine"},
+    "created_on": "2024-06-01T09:00:00Z",
+    "modified_on": "2024-06-01T09:00:00Z"
+  }
+}`)
+	})
+
+	created := time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC)
+	want := VectorizeIndex{
+		Name:       testVectorizeIndexName,
+		Config:     VectorizeIndexConfig{Dimensions: 768, Metric: VectorizeMetricCosine},
+		CreatedOn:  &created,
+		ModifiedOn: &created,
+	}
+
+	_, err := client.CreateVectorizeIndex(context.Background(), AccountIdentifier(testAccountID), VectorizeIndexParams{
+		Name:   testVectorizeIndexName,
+		Config: VectorizeIndexConfig{Dimensions: 768, Metric: "manhattan"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidVectorizeMetric)
+
+	actual, err := client.CreateVectorizeIndex(context.Background(), AccountIdentifier(testAccountID), VectorizeIndexParams{
+		Name:   testVectorizeIndexName,
+		Config: VectorizeIndexConfig{Dimensions: 768, Metric: VectorizeMetricCosine},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListVectorizeIndexes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/vectorize/v2/indexes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"name": "docs-search", "config": {"dimensions": 768, "metric": "cosine"}}
+  ]
+}`)
+	})
+
+	want := []VectorizeIndex{{
+		Name:   testVectorizeIndexName,
+		Config: VectorizeIndexConfig{Dimensions: 768, Metric: VectorizeMetricCosine},
+	}}
+
+	actual, err := client.ListVectorizeIndexes(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpsertVectorizeVectors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/vectorize/v2/indexes/"+testVectorizeIndexName+"/upsert", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"id":"doc-1","values":[0.1,0.2],"metadata":{"url":"/a"}}`+"\n"+`{"id":"doc-2","values":[0.3,0.4],"namespace":"blog"}`+"\n", string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"mutationId": "0000aaaa-11bb-22cc-33dd-444444eeeeee"}}`)
+	})
+
+	actual, err := client.UpsertVectorizeVectors(context.Background(), AccountIdentifier(testAccountID), testVectorizeIndexName, []VectorizeVector{
+		{ID: "doc-1", Values: []float64{0.1, 0.2}, Metadata: map[string]interface{}{"url": "/a"}},
+		{ID: "doc-2", Values: []float64{0.3, 0.4}, Namespace: "blog"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, VectorizeMutation{MutationID: "0000aaaa-11bb-22cc-33dd-444444eeeeee"}, actual)
+	}
+
+	_, err = client.UpsertVectorizeVectors(context.Background(), AccountIdentifier(testAccountID), testVectorizeIndexName, nil)
+	assert.ErrorIs(t, err, ErrMissingVectorizeVectors)
+}
+
+func TestQueryVectorizeIndex(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/vectorize/v2/indexes/"+testVectorizeIndexName+"/query", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"vector": [0.1, 0.2], "topK": 2, "returnMetadata": "all"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "count": 1,
+    "matches": [{"id": "doc-1", "score": 0.98, "metadata": {"url": "/a"}}]
+  }
+}`)
+	})
+
+	want := []VectorizeMatch{{ID: "doc-1", Score: 0.98, Metadata: map[string]interface{}{"url": "/a"}}}
+
+	actual, err := client.QueryVectorizeIndex(context.Background(), AccountIdentifier(testAccountID), testVectorizeIndexName, VectorizeQueryParams{
+		Vector:         []float64{0.1, 0.2},
+		TopK:           2,
+		ReturnMetadata: VectorizeReturnMetadataAll,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteVectorizeVectors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/vectorize/v2/indexes/"+testVectorizeIndexName+"/delete_by_ids", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"ids": ["doc-1"]}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"mutationId": "1111aaaa-11bb-22cc-33dd-444444eeeeee"}}`)
+	})
+
+	actual, err := client.DeleteVectorizeVectors(context.Background(), AccountIdentifier(testAccountID), testVectorizeIndexName, []string{"doc-1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, VectorizeMutation{MutationID: "1111aaaa-11bb-22cc-33dd-444444eeeeee"}, actual)
+	}
+}
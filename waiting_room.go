@@ -13,6 +13,7 @@ import (
 var (
 	ErrMissingWaitingRoomID     = errors.New("missing required waiting room ID")
 	ErrMissingWaitingRoomRuleID = errors.New("missing required waiting room rule ID")
+	ErrMissingWaitingRoomHTML   = errors.New("missing required waiting room custom page HTML")
 )
 
 // WaitingRoom describes a WaitingRoom object.
@@ -258,6 +259,14 @@ func (api *API) DeleteWaitingRoom(ctx context.Context, zoneID, waitingRoomID str
 //
 // API reference: https://api.cloudflare.com/#waiting-room-get-waiting-room-status
 func (api *API) WaitingRoomStatus(ctx context.Context, zoneID, waitingRoomID string) (WaitingRoomStatus, error) {
+	if zoneID == "" {
+		return WaitingRoomStatus{}, ErrMissingZoneID
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomStatus{}, ErrMissingWaitingRoomID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/waiting_rooms/%s/status", zoneID, waitingRoomID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -272,10 +281,19 @@ func (api *API) WaitingRoomStatus(ctx context.Context, zoneID, waitingRoomID str
 }
 
 // WaitingRoomPagePreview uploads a custom waiting room page for preview and
-// returns a preview URL.
+// returns a preview URL. Templates that fail validation, for example because
+// of invalid template variables, are rejected with the API's error.
 //
 // API reference: https://api.cloudflare.com/#waiting-room-create-a-custom-waiting-room-page-preview
 func (api *API) WaitingRoomPagePreview(ctx context.Context, zoneID, customHTML string) (WaitingRoomPagePreviewURL, error) {
+	if zoneID == "" {
+		return WaitingRoomPagePreviewURL{}, ErrMissingZoneID
+	}
+
+	if customHTML == "" {
+		return WaitingRoomPagePreviewURL{}, ErrMissingWaitingRoomHTML
+	}
+
 	uri := fmt.Sprintf("/zones/%s/waiting_rooms/preview", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, WaitingRoomPagePreviewCustomHTML{CustomHTML: customHTML})
 
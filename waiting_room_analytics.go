@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const waitingRoomAnalyticsQuery = `query WaitingRoomAnalytics($zoneTag: string, $waitingRoomId: string, $since: Time, $until: Time, $limit: uint64) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      waitingRoomAnalyticsAdaptiveGroups(limit: $limit, filter: {waitingRoomId: $waitingRoomId, datetime_geq: $since, datetime_leq: $until}, orderBy: [datetimeMinute_ASC]) {
+        dimensions {
+          datetimeMinute
+        }
+        max {
+          totalActiveUsers
+          totalQueuedUsers
+        }
+      }
+    }
+  }
+}`
+
+const defaultWaitingRoomAnalyticsLimit = 1000
+
+// GetWaitingRoomAnalyticsParams selects the time range of the data points
+// returned by GetWaitingRoomAnalytics.
+type GetWaitingRoomAnalyticsParams struct {
+	Since time.Time
+	Until time.Time
+
+	// Limit is the maximum number of data points returned, defaulting to
+	// 1000.
+	Limit int
+}
+
+// WaitingRoomAnalytics is the peak number of users that were queued and
+// active on the origin during a minute.
+type WaitingRoomAnalytics struct {
+	Time        time.Time
+	QueuedUsers int
+	ActiveUsers int
+}
+
+type waitingRoomAnalyticsRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type waitingRoomAnalyticsResponse struct {
+	Data struct {
+		Viewer struct {
+			Zones []struct {
+				Groups []struct {
+					Dimensions struct {
+						DatetimeMinute time.Time `json:"datetimeMinute"`
+					} `json:"dimensions"`
+					Max struct {
+						TotalActiveUsers int `json:"totalActiveUsers"`
+						TotalQueuedUsers int `json:"totalQueuedUsers"`
+					} `json:"max"`
+				} `json:"waitingRoomAnalyticsAdaptiveGroups"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GetWaitingRoomAnalytics returns the number of queued and active users of a
+// waiting room over time, one data point per minute, using the GraphQL
+// Analytics API.
+//
+// API reference: https://developers.cloudflare.com/waiting-room/waiting-room-analytics/
+func (api *API) GetWaitingRoomAnalytics(ctx context.Context, rc *ResourceContainer, waitingRoomID string, params GetWaitingRoomAnalyticsParams) ([]WaitingRoomAnalytics, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []WaitingRoomAnalytics{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []WaitingRoomAnalytics{}, ErrMissingZoneID
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomAnalytics{}, ErrMissingWaitingRoomID
+	}
+
+	if params.Until.IsZero() {
+		params.Until = time.Now().UTC()
+	}
+
+	if params.Since.IsZero() {
+		params.Since = params.Until.Add(-24 * time.Hour)
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultWaitingRoomAnalyticsLimit
+	}
+
+	req := waitingRoomAnalyticsRequest{
+		Query: waitingRoomAnalyticsQuery,
+		Variables: map[string]interface{}{
+			"zoneTag":       rc.Identifier,
+			"waitingRoomId": waitingRoomID,
+			"since":         params.Since.UTC().Format(time.RFC3339),
+			"until":         params.Until.UTC().Format(time.RFC3339),
+			"limit":         params.Limit,
+		},
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, "/graphql", req)
+	if err != nil {
+		return []WaitingRoomAnalytics{}, err
+	}
+
+	var r waitingRoomAnalyticsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomAnalytics{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if len(r.Errors) > 0 {
+		return []WaitingRoomAnalytics{}, errors.New(r.Errors[0].Message)
+	}
+
+	analytics := []WaitingRoomAnalytics{}
+	for _, zone := range r.Data.Viewer.Zones {
+		for _, group := range zone.Groups {
+			analytics = append(analytics, WaitingRoomAnalytics{
+				Time:        group.Dimensions.DatetimeMinute,
+				QueuedUsers: group.Max.TotalQueuedUsers,
+				ActiveUsers: group.Max.TotalActiveUsers,
+			})
+		}
+	}
+
+	return analytics, nil
+}
@@ -0,0 +1,77 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWaitingRoomAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		var req waitingRoomAnalyticsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, waitingRoomAnalyticsQuery, req.Query)
+		assert.Equal(t, testZoneID, req.Variables["zoneTag"])
+		assert.Equal(t, waitingRoomID, req.Variables["waitingRoomId"])
+		assert.Equal(t, "2023-06-01T00:00:00Z", req.Variables["since"])
+		assert.Equal(t, "2023-06-01T01:00:00Z", req.Variables["until"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "data": {
+    "viewer": {
+      "zones": [
+        {
+          "waitingRoomAnalyticsAdaptiveGroups": [
+            {"dimensions": {"datetimeMinute": "2023-06-01T00:00:00Z"}, "max": {"totalActiveUsers": 500, "totalQueuedUsers": 0}},
+            {"dimensions": {"datetimeMinute": "2023-06-01T00:01:00Z"}, "max": {"totalActiveUsers": 1000, "totalQueuedUsers": 250}}
+          ]
+        }
+      ]
+    }
+  },
+  "errors": null
+}`)
+	})
+
+	since := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	want := []WaitingRoomAnalytics{
+		{Time: since, ActiveUsers: 500},
+		{Time: since.Add(time.Minute), ActiveUsers: 1000, QueuedUsers: 250},
+	}
+
+	_, err := client.GetWaitingRoomAnalytics(context.Background(), ZoneIdentifier(testZoneID), "", GetWaitingRoomAnalyticsParams{})
+	assert.ErrorIs(t, err, ErrMissingWaitingRoomID)
+
+	actual, err := client.GetWaitingRoomAnalytics(context.Background(), ZoneIdentifier(testZoneID), waitingRoomID, GetWaitingRoomAnalyticsParams{
+		Since: since,
+		Until: since.Add(time.Hour),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestGetWaitingRoomAnalytics_GraphQLError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "zone does not have access to the waitingRoomAnalyticsAdaptiveGroups dataset"}]}`)
+	})
+
+	_, err := client.GetWaitingRoomAnalytics(context.Background(), ZoneIdentifier(testZoneID), waitingRoomID, GetWaitingRoomAnalyticsParams{})
+	assert.EqualError(t, err, "zone does not have access to the waitingRoomAnalyticsAdaptiveGroups dataset")
+}
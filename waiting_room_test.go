@@ -464,6 +464,34 @@ func TestWaitingRoomPagePreview(t *testing.T) {
 	}
 }
 
+func TestWaitingRoomPagePreview_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/waiting_rooms/preview", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 1020, "message": "custom_html: invalid template variable waitTimez"}],
+			"messages": [],
+			"result": null
+		}`)
+	})
+
+	_, err := client.WaitingRoomPagePreview(context.Background(), testZoneID, "")
+	assert.ErrorIs(t, err, ErrMissingWaitingRoomHTML)
+
+	_, err = client.WaitingRoomPagePreview(context.Background(), testZoneID, "{{waitTimez}}")
+	var requestErr *RequestError
+	if assert.ErrorAs(t, err, &requestErr) {
+		assert.Contains(t, requestErr.ErrorMessages(), "custom_html: invalid template variable waitTimez")
+	}
+
+	_, err = client.WaitingRoomStatus(context.Background(), testZoneID, "")
+	assert.ErrorIs(t, err, ErrMissingWaitingRoomID)
+}
+
 func TestCreateWaitingRoomEvent(t *testing.T) {
 	setup()
 	defer teardown()
@@ -387,6 +387,10 @@ func (api *API) GetWorkersScriptContent(ctx context.Context, rc *ResourceContain
 		return "", ErrMissingAccountID
 	}
 
+	if scriptName == "" {
+		return "", ErrMissingScriptName
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/content/v2", rc.Identifier, scriptName)
 	res, err := api.makeRequestContextWithHeadersComplete(ctx, http.MethodGet, uri, nil, nil)
 	if err != nil {
@@ -477,6 +481,77 @@ func (api *API) GetWorkersScriptSettings(ctx context.Context, rc *ResourceContai
 	return r, nil
 }
 
+// WorkerScriptConfiguration is the configuration a Worker script is deployed
+// with.
+type WorkerScriptConfiguration struct {
+	Bindings           []WorkerBindingListItem
+	CompatibilityDate  string
+	CompatibilityFlags []string
+	Logpush            *bool
+	TailConsumers      []WorkersTailConsumer
+	Placement          *Placement
+	UsageModel         string
+}
+
+type workerScriptConfigurationResponse struct {
+	Response
+	Result struct {
+		Bindings           []workerBindingMeta   `json:"bindings"`
+		CompatibilityDate  string                `json:"compatibility_date"`
+		CompatibilityFlags []string              `json:"compatibility_flags"`
+		Logpush            *bool                 `json:"logpush"`
+		TailConsumers      []WorkersTailConsumer `json:"tail_consumers"`
+		Placement          *Placement            `json:"placement"`
+		UsageModel         string                `json:"usage_model"`
+	} `json:"result"`
+}
+
+// GetWorkersScriptConfiguration returns the bindings, compatibility date and
+// flags, Logpush and tail consumer settings of a deployed Worker, allowing
+// deployments to be verified without downloading or uploading the script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-get-settings
+func (api *API) GetWorkersScriptConfiguration(ctx context.Context, rc *ResourceContainer, scriptName string) (WorkerScriptConfiguration, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerScriptConfiguration{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerScriptConfiguration{}, ErrMissingAccountID
+	}
+
+	if scriptName == "" {
+		return WorkerScriptConfiguration{}, ErrMissingScriptName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/settings", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerScriptConfiguration{}, err
+	}
+
+	var r workerScriptConfigurationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerScriptConfiguration{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	bindings, err := api.workerBindingListItems(ctx, rc, ListWorkerBindingsParams{ScriptName: scriptName}, r.Result.Bindings)
+	if err != nil {
+		return WorkerScriptConfiguration{}, err
+	}
+
+	return WorkerScriptConfiguration{
+		Bindings:           bindings,
+		CompatibilityDate:  r.Result.CompatibilityDate,
+		CompatibilityFlags: r.Result.CompatibilityFlags,
+		Logpush:            r.Result.Logpush,
+		TailConsumers:      r.Result.TailConsumers,
+		Placement:          r.Result.Placement,
+		UsageModel:         r.Result.UsageModel,
+	}, nil
+}
+
 // UpdateWorkersScriptSettings pushes only script metadata.
 //
 // API reference: https://developers.cloudflare.com/api/operations/worker-script-patch-settings
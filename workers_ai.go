@@ -0,0 +1,113 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingWorkersAIModel       = errors.New("required Workers AI model missing")
+	ErrUnsupportedWorkersAIOutput  = errors.New("binary Workers AI responses can only be decoded into *[]byte or io.Writer")
+	ErrUnexpectedWorkersAIResponse = errors.New("unexpected Workers AI response")
+)
+
+// RunWorkersAIModelParams are the parameters for running a Workers AI model.
+type RunWorkersAIModelParams struct {
+	// Model is the name of the model, for example
+	// `@cf/meta/llama-3-8b-instruct`.
+	Model string
+
+	// Input is the model input, usually a struct or map encoded as JSON. An
+	// io.Reader is sent as is, for models taking binary input such as
+	// images or audio.
+	Input interface{}
+
+	// Stream receives the response body as it arrives instead of it being
+	// decoded into the output. Text generation models only stream their
+	// response as server-sent events when `"stream": true` is part of the
+	// input.
+	Stream io.Writer
+}
+
+// RunWorkersAIModel runs a Workers AI model. The `result` of JSON responses,
+// such as generated text or embeddings, is decoded into out. Binary responses,
+// such as generated images, are written to out when it is a *[]byte or an
+// io.Writer. When params.Stream is set the response is copied to it as it
+// arrives and out is not used.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-ai-post-run-model
+func (api *API) RunWorkersAIModel(ctx context.Context, rc *ResourceContainer, params RunWorkersAIModelParams, out interface{}) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if params.Model == "" {
+		return ErrMissingWorkersAIModel
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai/run/%s", rc.Identifier, strings.TrimPrefix(params.Model, "/"))
+
+	if params.Stream != nil {
+		body, err := api.openRequestStream(ctx, http.MethodPost, uri, params.Input, nil)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		_, err = io.Copy(params.Stream, body)
+		return err
+	}
+
+	res, err := api.makeRequestContextWithHeadersComplete(ctx, http.MethodPost, uri, params.Input, nil)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(res.Headers.Get("Content-Type"), "application/json") {
+		var r struct {
+			Response
+			Result json.RawMessage `json:"result"`
+		}
+		err = json.Unmarshal(res.Body, &r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		if out == nil {
+			return nil
+		}
+
+		if len(r.Result) == 0 {
+			return fmt.Errorf("%w: missing result", ErrUnexpectedWorkersAIResponse)
+		}
+
+		err = json.Unmarshal(r.Result, out)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		return nil
+	}
+
+	switch o := out.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*o = res.Body
+		return nil
+	case io.Writer:
+		_, err = o.Write(res.Body)
+		return err
+	default:
+		return ErrUnsupportedWorkersAIOutput
+	}
+}
@@ -0,0 +1,95 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunWorkersAIModel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/baai/bge-small-en-v1.5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"text": ["hello"]}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"shape": [1, 3], "data": [[0.1, 0.2, 0.3]]}}`)
+	})
+
+	var out struct {
+		Shape []int       `json:"shape"`
+		Data  [][]float64 `json:"data"`
+	}
+
+	err := client.RunWorkersAIModel(context.Background(), AccountIdentifier(testAccountID), RunWorkersAIModelParams{
+		Model: "@cf/baai/bge-small-en-v1.5",
+		Input: map[string]interface{}{"text": []string{"hello"}},
+	}, &out)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []int{1, 3}, out.Shape)
+		assert.Equal(t, [][]float64{{0.1, 0.2, 0.3}}, out.Data)
+	}
+
+	err = client.RunWorkersAIModel(context.Background(), AccountIdentifier(testAccountID), RunWorkersAIModelParams{}, &out)
+	assert.ErrorIs(t, err, ErrMissingWorkersAIModel)
+}
+
+func TestRunWorkersAIModel_Binary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/stabilityai/stable-diffusion-xl-base-1.0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "image/png")
+		_, _ = w.Write(png)
+	})
+
+	params := RunWorkersAIModelParams{
+		Model: "@cf/stabilityai/stable-diffusion-xl-base-1.0",
+		Input: map[string]string{"prompt": "a cyberpunk lizard"},
+	}
+
+	var image []byte
+	err := client.RunWorkersAIModel(context.Background(), AccountIdentifier(testAccountID), params, &image)
+	if assert.NoError(t, err) {
+		assert.Equal(t, png, image)
+	}
+
+	var out map[string]interface{}
+	err = client.RunWorkersAIModel(context.Background(), AccountIdentifier(testAccountID), params, &out)
+	assert.ErrorIs(t, err, ErrUnsupportedWorkersAIOutput)
+}
+
+func TestRunWorkersAIModel_Stream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	events := "data: {\"response\":\"Hello\"}\n\ndata: {\"response\":\" world\"}\n\ndata: [DONE]\n\n"
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/meta/llama-3-8b-instruct", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"prompt": "Say hello", "stream": true}`, string(body))
+
+		w.Header().Set("content-type", "text/event-stream")
+		fmt.Fprint(w, events)
+	})
+
+	var buf bytes.Buffer
+	err := client.RunWorkersAIModel(context.Background(), AccountIdentifier(testAccountID), RunWorkersAIModelParams{
+		Model:  "@cf/meta/llama-3-8b-instruct",
+		Input:  map[string]interface{}{"prompt": "Say hello", "stream": true},
+		Stream: &buf,
+	}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, events, buf.String())
+	}
+}
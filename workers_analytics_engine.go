@@ -0,0 +1,68 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingAnalyticsEngineQuery = errors.New("required SQL query missing")
+
+// WorkersAnalyticsEngineColumn describes a column returned by a Workers
+// Analytics Engine SQL query.
+type WorkersAnalyticsEngineColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// WorkersAnalyticsEngineQueryResult is the result of a Workers Analytics
+// Engine SQL query. Each entry of Data is a row keyed by column name.
+type WorkersAnalyticsEngineQueryResult struct {
+	Meta            []WorkersAnalyticsEngineColumn `json:"meta"`
+	Data            []map[string]interface{}       `json:"data"`
+	Rows            int                            `json:"rows"`
+	RowsBeforeLimit int                            `json:"rows_before_limit_at_least"`
+}
+
+// QueryWorkersAnalyticsEngine runs a SQL query against the Workers Analytics
+// Engine datasets of an account.
+//
+// The endpoint returns the query result directly rather than the usual API
+// response envelope.
+//
+// API reference: https://developers.cloudflare.com/analytics/analytics-engine/sql-api/
+func (api *API) QueryWorkersAnalyticsEngine(ctx context.Context, accountID, sql string) (WorkersAnalyticsEngineQueryResult, error) {
+	if accountID == "" {
+		return WorkersAnalyticsEngineQueryResult{}, ErrMissingAccountID
+	}
+
+	if sql == "" {
+		return WorkersAnalyticsEngineQueryResult{}, ErrMissingAnalyticsEngineQuery
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", "text/plain")
+
+	uri := fmt.Sprintf("/accounts/%s/analytics_engine/sql", accountID)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, []byte(sql), headers)
+	if err != nil {
+		return WorkersAnalyticsEngineQueryResult{}, err
+	}
+
+	// The SQL API reports some errors as plain text rather than JSON.
+	if !json.Valid(res) {
+		return WorkersAnalyticsEngineQueryResult{}, errors.New(strings.TrimSpace(string(res)))
+	}
+
+	var r WorkersAnalyticsEngineQueryResult
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersAnalyticsEngineQueryResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryWorkersAnalyticsEngine(t *testing.T) {
+	setup()
+	defer teardown()
+
+	query := "SELECT blob1 AS path, SUM(_sample_interval) AS requests FROM my_dataset GROUP BY path LIMIT 2"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, query, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "meta": [
+    {"name": "path", "type": "String"},
+    {"name": "requests", "type": "UInt64"}
+  ],
+  "data": [
+    {"path": "/", "requests": 42},
+    {"path": "/about", "requests": 7}
+  ],
+  "rows": 2,
+  "rows_before_limit_at_least": 10
+}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/analytics_engine/sql", handler)
+
+	want := WorkersAnalyticsEngineQueryResult{
+		Meta: []WorkersAnalyticsEngineColumn{
+			{Name: "path", Type: "String"},
+			{Name: "requests", Type: "UInt64"},
+		},
+		Data: []map[string]interface{}{
+			{"path": "/", "requests": float64(42)},
+			{"path": "/about", "requests": float64(7)},
+		},
+		Rows:            2,
+		RowsBeforeLimit: 10,
+	}
+
+	actual, err := client.QueryWorkersAnalyticsEngine(context.Background(), testAccountID, query)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.QueryWorkersAnalyticsEngine(context.Background(), testAccountID, "")
+	assert.ErrorIs(t, err, ErrMissingAnalyticsEngineQuery)
+}
+
+func TestQueryWorkersAnalyticsEngine_PlainTextError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	status := http.StatusBadRequest
+	mux.HandleFunc("/accounts/"+testAccountID+"/analytics_engine/sql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "text/plain")
+		w.WriteHeader(status)
+		fmt.Fprint(w, "unknown table: my_datset\n")
+	})
+
+	_, err := client.QueryWorkersAnalyticsEngine(context.Background(), testAccountID, "SELECT * FROM my_datset")
+	var requestErr *RequestError
+	if assert.ErrorAs(t, err, &requestErr) {
+		assert.Equal(t, []string{"unknown table: my_datset"}, requestErr.ErrorMessages())
+		assert.Contains(t, err.Error(), "unknown table: my_datset")
+	}
+
+	status = http.StatusOK
+	_, err = client.QueryWorkersAnalyticsEngine(context.Background(), testAccountID, "SELECT * FROM my_datset")
+	assert.EqualError(t, err, "unknown table: my_datset")
+}
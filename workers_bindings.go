@@ -460,17 +460,28 @@ func (api *API) ListWorkerBindings(ctx context.Context, rc *ResourceContainer, p
 	}
 
 	r = WorkerBindingListResponse{
-		Response:    jsonRes.Response,
-		BindingList: make([]WorkerBindingListItem, 0, len(jsonRes.Bindings)),
+		Response: jsonRes.Response,
 	}
-	for _, jsonBinding := range jsonRes.Bindings {
+	r.BindingList, err = api.workerBindingListItems(ctx, rc, params, jsonRes.Bindings)
+	if err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// workerBindingListItems converts the bindings of a script returned by the
+// API into their typed representation.
+func (api *API) workerBindingListItems(ctx context.Context, rc *ResourceContainer, params ListWorkerBindingsParams, jsonBindings []workerBindingMeta) ([]WorkerBindingListItem, error) {
+	bindings := make([]WorkerBindingListItem, 0, len(jsonBindings))
+	for _, jsonBinding := range jsonBindings {
 		name, ok := jsonBinding["name"].(string)
 		if !ok {
-			return r, fmt.Errorf("Binding missing name %v", jsonBinding)
+			return bindings, fmt.Errorf("Binding missing name %v", jsonBinding)
 		}
 		bType, ok := jsonBinding["type"].(string)
 		if !ok {
-			return r, fmt.Errorf("Binding missing type %v", jsonBinding)
+			return bindings, fmt.Errorf("Binding missing type %v", jsonBinding)
 		}
 		bindingListItem := WorkerBindingListItem{
 			Name: name,
@@ -532,10 +543,10 @@ func (api *API) ListWorkerBindings(ctx context.Context, rc *ResourceContainer, p
 		default:
 			bindingListItem.Binding = WorkerInheritBinding{}
 		}
-		r.BindingList = append(r.BindingList, bindingListItem)
+		bindings = append(bindings, bindingListItem)
 	}
 
-	return r, nil
+	return bindings, nil
 }
 
 // bindingContentReader is an io.Reader that will lazily load the
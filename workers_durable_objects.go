@@ -0,0 +1,118 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingDurableObjectNamespaceID = errors.New("required durable object namespace ID missing")
+
+// DurableObjectNamespace is a Durable Objects namespace, backed by a class
+// exported from a Worker script.
+type DurableObjectNamespace struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Script    string `json:"script"`
+	Class     string `json:"class"`
+	UseSQLite *bool  `json:"use_sqlite,omitempty"`
+}
+
+// DurableObject is a single object within a Durable Objects namespace.
+type DurableObject struct {
+	ID            string `json:"id"`
+	HasStoredData bool   `json:"hasStoredData"`
+}
+
+// ListDurableObjectsNamespacesResponse is the response from listing the
+// Durable Objects namespaces of an account.
+type ListDurableObjectsNamespacesResponse struct {
+	Response
+	Result []DurableObjectNamespace `json:"result"`
+}
+
+// ListDurableObjectsResponse is the response from listing the objects of a
+// Durable Objects namespace.
+type ListDurableObjectsResponse struct {
+	Response
+	Result     []DurableObject `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+type listDurableObjectsParams struct {
+	CursorPaginationOptions
+}
+
+// ListDurableObjectsNamespaces returns the Durable Objects namespaces of an
+// account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/durable-objects-namespace-list-namespaces
+func (api *API) ListDurableObjectsNamespaces(ctx context.Context, rc *ResourceContainer) ([]DurableObjectNamespace, error) {
+	if rc.Level != AccountRouteLevel {
+		return []DurableObjectNamespace{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []DurableObjectNamespace{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/durable_objects/namespaces", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []DurableObjectNamespace{}, err
+	}
+
+	var r ListDurableObjectsNamespacesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DurableObjectNamespace{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListDurableObjects returns all of the objects within a Durable Objects
+// namespace, following the cursor until every page has been fetched.
+//
+// API reference: https://developers.cloudflare.com/api/operations/durable-objects-namespace-list-objects
+func (api *API) ListDurableObjects(ctx context.Context, rc *ResourceContainer, namespaceID string) ([]DurableObject, error) {
+	if rc.Level != AccountRouteLevel {
+		return []DurableObject{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []DurableObject{}, ErrMissingAccountID
+	}
+
+	if namespaceID == "" {
+		return []DurableObject{}, ErrMissingDurableObjectNamespaceID
+	}
+
+	var objects []DurableObject
+	params := listDurableObjectsParams{}
+
+	for {
+		uri := buildURI(fmt.Sprintf("/accounts/%s/workers/durable_objects/namespaces/%s/objects", rc.Identifier, namespaceID), params)
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []DurableObject{}, err
+		}
+
+		var r ListDurableObjectsResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []DurableObject{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		objects = append(objects, r.Result...)
+		if r.ResultInfo.NextCursor() == "" || len(r.Result) == 0 {
+			break
+		}
+		params.Cursor = r.ResultInfo.NextCursor()
+	}
+
+	return objects, nil
+}
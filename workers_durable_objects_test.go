@@ -0,0 +1,100 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDurableObjectNamespaceID = "5fd1cafff895419c8bcc647fc64ab8f0"
+
+func TestListDurableObjectsNamespaces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "%s",
+      "name": "counter-worker_Counter",
+      "script": "counter-worker",
+      "class": "Counter",
+      "use_sqlite": true
+    }
+  ]
+}`, testDurableObjectNamespaceID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/durable_objects/namespaces", handler)
+
+	want := []DurableObjectNamespace{
+		{
+			ID:        testDurableObjectNamespaceID,
+			Name:      "counter-worker_Counter",
+			Script:    "counter-worker",
+			Class:     "Counter",
+			UseSQLite: BoolPtr(true),
+		},
+	}
+
+	actual, err := client.ListDurableObjectsNamespaces(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListDurableObjectsNamespaces(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
+
+func TestListDurableObjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [{"id": "fe7803fc55b964e09d94666545aab688d360c6bda69ba349ced1e5f28d2fc2c8", "hasStoredData": true}],
+  "result_info": {"count": 1, "cursor": "AAAAANuhDN7SjacTnSVsDu3WW1Lvst6dxJGTjRY5BhxPXdf6L6uTcpd_NVtjhn11OUYRsVEykxoUwF-JQU4dn6QylZSKTOJuG0indrdn_MlHpMRtsxgXjs-RPdHYIVm3odE_uvEQ_dTQGFm8oikZMohns34DLBgrQpc"}
+}`)
+			return
+		}
+
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [{"id": "2b9e5f1c0e5b4e7d5a4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e", "hasStoredData": false}],
+  "result_info": {"count": 1, "cursor": ""}
+}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/durable_objects/namespaces/"+testDurableObjectNamespaceID+"/objects", handler)
+
+	want := []DurableObject{
+		{ID: "fe7803fc55b964e09d94666545aab688d360c6bda69ba349ced1e5f28d2fc2c8", HasStoredData: true},
+		{ID: "2b9e5f1c0e5b4e7d5a4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e", HasStoredData: false},
+	}
+
+	actual, err := client.ListDurableObjects(context.Background(), AccountIdentifier(testAccountID), testDurableObjectNamespaceID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListDurableObjects(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingDurableObjectNamespaceID)
+}
@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingDispatchNamespaceName = errors.New("required dispatch namespace name missing")
+
+// WorkersForPlatformsDispatchNamespace is a Workers for Platforms dispatch
+// namespace that user Workers are uploaded into.
+type WorkersForPlatformsDispatchNamespace struct {
+	NamespaceID   string     `json:"namespace_id"`
+	NamespaceName string     `json:"namespace_name"`
+	CreatedOn     *time.Time `json:"created_on,omitempty"`
+	CreatedBy     string     `json:"created_by"`
+	ModifiedOn    *time.Time `json:"modified_on,omitempty"`
+	ModifiedBy    string     `json:"modified_by"`
+	ScriptCount   int        `json:"script_count"`
+}
+
+type ListWorkersForPlatformsDispatchNamespaceResponse struct {
+	Response
+	Result []WorkersForPlatformsDispatchNamespace `json:"result"`
+}
+
+type GetWorkersForPlatformsDispatchNamespaceResponse struct {
+	Response
+	Result WorkersForPlatformsDispatchNamespace `json:"result"`
+}
+
+type CreateWorkersForPlatformsDispatchNamespaceParams struct {
+	Name string `json:"name"`
+}
+
+// ListWorkersForPlatformsDispatchNamespaces lists the dispatch namespaces of
+// an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/namespace-worker-list
+func (api *API) ListWorkersForPlatformsDispatchNamespaces(ctx context.Context, rc *ResourceContainer) ([]WorkersForPlatformsDispatchNamespace, error) {
+	if rc.Level != AccountRouteLevel {
+		return []WorkersForPlatformsDispatchNamespace{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []WorkersForPlatformsDispatchNamespace{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []WorkersForPlatformsDispatchNamespace{}, err
+	}
+
+	var r ListWorkersForPlatformsDispatchNamespaceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WorkersForPlatformsDispatchNamespace{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetWorkersForPlatformsDispatchNamespace gets a single dispatch namespace.
+//
+// API reference: https://developers.cloudflare.com/api/operations/namespace-worker-get-namespace
+func (api *API) GetWorkersForPlatformsDispatchNamespace(ctx context.Context, rc *ResourceContainer, name string) (WorkersForPlatformsDispatchNamespace, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersForPlatformsDispatchNamespace{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersForPlatformsDispatchNamespace{}, ErrMissingAccountID
+	}
+
+	if name == "" {
+		return WorkersForPlatformsDispatchNamespace{}, ErrMissingDispatchNamespaceName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", rc.Identifier, name)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkersForPlatformsDispatchNamespace{}, err
+	}
+
+	var r GetWorkersForPlatformsDispatchNamespaceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersForPlatformsDispatchNamespace{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateWorkersForPlatformsDispatchNamespace creates a new dispatch namespace.
+//
+// API reference: https://developers.cloudflare.com/api/operations/namespace-worker-create
+func (api *API) CreateWorkersForPlatformsDispatchNamespace(ctx context.Context, rc *ResourceContainer, name string) (WorkersForPlatformsDispatchNamespace, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersForPlatformsDispatchNamespace{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersForPlatformsDispatchNamespace{}, ErrMissingAccountID
+	}
+
+	if name == "" {
+		return WorkersForPlatformsDispatchNamespace{}, ErrMissingDispatchNamespaceName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, CreateWorkersForPlatformsDispatchNamespaceParams{Name: name})
+	if err != nil {
+		return WorkersForPlatformsDispatchNamespace{}, err
+	}
+
+	var r GetWorkersForPlatformsDispatchNamespaceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersForPlatformsDispatchNamespace{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteWorkersForPlatformsDispatchNamespace deletes a dispatch namespace
+// along with all of the Workers uploaded into it.
+//
+// API reference: https://developers.cloudflare.com/api/operations/namespace-worker-delete-namespace
+func (api *API) DeleteWorkersForPlatformsDispatchNamespace(ctx context.Context, rc *ResourceContainer, name string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if name == "" {
+		return ErrMissingDispatchNamespaceName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", rc.Identifier, name)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UploadWorkerToDispatchNamespace uploads a user Worker into a dispatch
+// namespace. It is equivalent to calling UploadWorker with
+// `DispatchNamespaceName` set.
+//
+// API reference: https://developers.cloudflare.com/api/operations/namespace-worker-script-upload-worker-module
+func (api *API) UploadWorkerToDispatchNamespace(ctx context.Context, rc *ResourceContainer, namespace string, params CreateWorkerParams) (WorkerScriptResponse, error) {
+	if namespace == "" {
+		return WorkerScriptResponse{}, ErrMissingDispatchNamespaceName
+	}
+
+	params.DispatchNamespaceName = &namespace
+	return api.UploadWorker(ctx, rc, params)
+}
@@ -0,0 +1,164 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDispatchNamespaceName = "my-dispatch-namespace"
+
+var (
+	dispatchNamespaceCreatedOn, _  = time.Parse(time.RFC3339, "2023-09-07T13:38:39.083991Z")
+	dispatchNamespaceModifiedOn, _ = time.Parse(time.RFC3339, "2023-09-08T13:38:39.083991Z")
+
+	expectedDispatchNamespace = WorkersForPlatformsDispatchNamespace{
+		NamespaceID:   "6c4c1f5c9c1e4ad2a5e26b9e5f3b0f93",
+		NamespaceName: testDispatchNamespaceName,
+		CreatedOn:     &dispatchNamespaceCreatedOn,
+		CreatedBy:     "4e599df4216133509abaac54b109a647",
+		ModifiedOn:    &dispatchNamespaceModifiedOn,
+		ModifiedBy:    "4e599df4216133509abaac54b109a647",
+		ScriptCount:   2,
+	}
+)
+
+const dispatchNamespaceJSON = `{
+  "namespace_id": "6c4c1f5c9c1e4ad2a5e26b9e5f3b0f93",
+  "namespace_name": "my-dispatch-namespace",
+  "created_on": "2023-09-07T13:38:39.083991Z",
+  "created_by": "4e599df4216133509abaac54b109a647",
+  "modified_on": "2023-09-08T13:38:39.083991Z",
+  "modified_by": "4e599df4216133509abaac54b109a647",
+  "script_count": 2
+}`
+
+func TestListWorkersForPlatformsDispatchNamespaces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [%s]
+}`, dispatchNamespaceJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/dispatch/namespaces", handler)
+
+	actual, err := client.ListWorkersForPlatformsDispatchNamespaces(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []WorkersForPlatformsDispatchNamespace{expectedDispatchNamespace}, actual)
+	}
+
+	_, err = client.ListWorkersForPlatformsDispatchNamespaces(context.Background(), ZoneIdentifier(testZoneID))
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
+
+func TestGetWorkersForPlatformsDispatchNamespace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": %s
+}`, dispatchNamespaceJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/dispatch/namespaces/"+testDispatchNamespaceName, handler)
+
+	actual, err := client.GetWorkersForPlatformsDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), testDispatchNamespaceName)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedDispatchNamespace, actual)
+	}
+
+	_, err = client.GetWorkersForPlatformsDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingDispatchNamespaceName)
+}
+
+func TestCreateWorkersForPlatformsDispatchNamespace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "my-dispatch-namespace"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": %s
+}`, dispatchNamespaceJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/dispatch/namespaces", handler)
+
+	actual, err := client.CreateWorkersForPlatformsDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), testDispatchNamespaceName)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedDispatchNamespace, actual)
+	}
+}
+
+func TestDeleteWorkersForPlatformsDispatchNamespace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": null
+}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/dispatch/namespaces/"+testDispatchNamespaceName, handler)
+
+	err := client.DeleteWorkersForPlatformsDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), testDispatchNamespaceName)
+	assert.NoError(t, err)
+}
+
+func TestUploadWorkerToDispatchNamespace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, workerScript, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, workersScriptResponse(t))
+	}
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/dispatch/namespaces/"+testDispatchNamespaceName+"/scripts/bar", handler)
+
+	_, err := client.UploadWorkerToDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), testDispatchNamespaceName, CreateWorkerParams{
+		ScriptName: "bar",
+		Script:     workerScript,
+	})
+	assert.NoError(t, err)
+
+	_, err = client.UploadWorkerToDispatchNamespace(context.Background(), AccountIdentifier(testAccountID), "", CreateWorkerParams{ScriptName: "bar"})
+	assert.ErrorIs(t, err, ErrMissingDispatchNamespaceName)
+}
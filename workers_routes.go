@@ -9,7 +9,10 @@ import (
 	"github.com/goccy/go-json"
 )
 
-var ErrMissingWorkerRouteID = errors.New("missing required route ID")
+var (
+	ErrMissingWorkerRouteID      = errors.New("missing required route ID")
+	ErrMissingWorkerRoutePattern = errors.New("missing required route pattern")
+)
 
 type ListWorkerRoutes struct{}
 
@@ -38,6 +41,10 @@ func (api *API) CreateWorkerRoute(ctx context.Context, rc *ResourceContainer, pa
 		return WorkerRouteResponse{}, ErrMissingIdentifier
 	}
 
+	if params.Pattern == "" {
+		return WorkerRouteResponse{}, ErrMissingWorkerRoutePattern
+	}
+
 	uri := fmt.Sprintf("/zones/%s/workers/routes", rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -65,7 +72,7 @@ func (api *API) DeleteWorkerRoute(ctx context.Context, rc *ResourceContainer, ro
 	}
 
 	if routeID == "" {
-		return WorkerRouteResponse{}, errors.New("missing required route ID")
+		return WorkerRouteResponse{}, ErrMissingWorkerRouteID
 	}
 
 	uri := fmt.Sprintf("/zones/%s/workers/routes/%s", rc.Identifier, routeID)
@@ -119,6 +126,10 @@ func (api *API) GetWorkerRoute(ctx context.Context, rc *ResourceContainer, route
 		return WorkerRouteResponse{}, ErrMissingIdentifier
 	}
 
+	if routeID == "" {
+		return WorkerRouteResponse{}, ErrMissingWorkerRouteID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/workers/routes/%s", rc.Identifier, routeID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -148,6 +159,10 @@ func (api *API) UpdateWorkerRoute(ctx context.Context, rc *ResourceContainer, pa
 		return WorkerRouteResponse{}, ErrMissingWorkerRouteID
 	}
 
+	if params.Pattern == "" {
+		return WorkerRouteResponse{}, ErrMissingWorkerRoutePattern
+	}
+
 	uri := fmt.Sprintf("/zones/%s/workers/routes/%s", rc.Identifier, params.ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
 	if err != nil {
@@ -119,3 +119,20 @@ func TestUpdateWorkersRoute(t *testing.T) {
 		assert.Equal(t, want, res)
 	}
 }
+
+func TestWorkersRoute_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CreateWorkerRoute(context.Background(), ZoneIdentifier(testZoneID), CreateWorkerRouteParams{Script: "example"})
+	assert.ErrorIs(t, err, ErrMissingWorkerRoutePattern)
+
+	_, err = client.UpdateWorkerRoute(context.Background(), ZoneIdentifier(testZoneID), UpdateWorkerRouteParams{ID: "e7a57d8746e74ae49c25994dadb421b1"})
+	assert.ErrorIs(t, err, ErrMissingWorkerRoutePattern)
+
+	_, err = client.GetWorkerRoute(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingWorkerRouteID)
+
+	_, err = client.DeleteWorkerRoute(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingWorkerRouteID)
+}
@@ -2,17 +2,25 @@ package cloudflare
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/goccy/go-json"
+	"golang.org/x/net/websocket"
 )
 
+// workersTailProtocol is the WebSocket subprotocol spoken by tail sessions.
+const workersTailProtocol = "trace-v1"
+
 var (
 	ErrMissingScriptName = errors.New("required script name missing")
 	ErrMissingTailID     = errors.New("required tail id missing")
+	ErrMissingTailURL    = errors.New("required tail URL missing")
 )
 
 type WorkersTail struct {
@@ -112,3 +120,175 @@ func (api *API) DeleteWorkersTail(ctx context.Context, rc *ResourceContainer, sc
 
 	return nil
 }
+
+// WorkersTailEvent is a single invocation of a Worker received from a tail.
+type WorkersTailEvent struct {
+	Outcome        string                 `json:"outcome"`
+	ScriptName     string                 `json:"scriptName"`
+	Logs           []WorkersTailLog       `json:"logs"`
+	Exceptions     []WorkersTailException `json:"exceptions"`
+	EventTimestamp int64                  `json:"eventTimestamp"`
+
+	// Event describes what triggered the invocation, such as the request
+	// for fetch events or the schedule for scheduled events.
+	Event json.RawMessage `json:"event"`
+}
+
+// WorkersTailLog is a message logged with `console` during an invocation.
+type WorkersTailLog struct {
+	Message   []interface{} `json:"message"`
+	Level     string        `json:"level"`
+	Timestamp int64         `json:"timestamp"`
+}
+
+// WorkersTailException is an uncaught exception thrown during an invocation.
+type WorkersTailException struct {
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type workersTailFilters struct {
+	Filters []interface{} `json:"filters"`
+}
+
+// WorkersTailStream receives the invocations of a Worker from a tail session.
+type WorkersTailStream struct {
+	// Events receives the invocations of the Worker. It is closed when the
+	// stream ends, after which Err reports why.
+	Events <-chan WorkersTailEvent
+
+	err error
+}
+
+// Err returns the error that ended the stream once Events has been closed. It
+// is nil when the tail was closed by the server, for example when it expired,
+// and the context's error when the context was cancelled.
+func (s *WorkersTailStream) Err() error {
+	return s.err
+}
+
+// StreamWorkersTail connects to a tail started with StartWorkersTail and
+// sends the invocations of the Worker to the Events channel of the returned
+// stream until ctx is cancelled, the tail is closed by the server or
+// receiving fails. The tail itself is not deleted.
+func (api *API) StreamWorkersTail(ctx context.Context, tail WorkersTail) (*WorkersTailStream, error) {
+	if tail.URL == "" {
+		return nil, ErrMissingTailURL
+	}
+
+	config, err := websocket.NewConfig(tail.URL, api.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	config.Protocol = []string{workersTailProtocol}
+	config.Header.Set("User-Agent", api.UserAgent)
+
+	conn, err := dialWebsocket(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := websocket.JSON.Send(conn, workersTailFilters{Filters: []interface{}{}}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		conn.Close()
+	}()
+
+	events := make(chan WorkersTailEvent)
+	stream := &WorkersTailStream{Events: events}
+	go func() {
+		defer close(events)
+		defer close(done)
+
+		stream.err = receiveWorkersTailEvents(ctx, conn, events)
+	}()
+
+	return stream, nil
+}
+
+// receiveWorkersTailEvents sends the events received on conn to events until
+// the connection is closed or ctx is cancelled.
+func receiveWorkersTailEvents(ctx context.Context, conn *websocket.Conn, events chan<- WorkersTailEvent) error {
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("receiving tail event failed: %w", err)
+		}
+
+		var event WorkersTailEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			return fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dialWebsocket opens a WebSocket connection, aborting the connection and the
+// handshake when ctx is cancelled.
+func dialWebsocket(ctx context.Context, config *websocket.Config) (*websocket.Conn, error) {
+	addr := config.Location.Host
+	if config.Location.Port() == "" {
+		switch config.Location.Scheme {
+		case "ws":
+			addr = net.JoinHostPort(config.Location.Hostname(), "80")
+		case "wss":
+			addr = net.JoinHostPort(config.Location.Hostname(), "443")
+		}
+	}
+
+	var netConn net.Conn
+	var err error
+	dialer := &net.Dialer{}
+	switch config.Location.Scheme {
+	case "ws":
+		netConn, err = dialer.DialContext(ctx, "tcp", addr)
+	case "wss":
+		netConn, err = (&tls.Dialer{NetDialer: dialer, Config: config.TlsConfig}).DialContext(ctx, "tcp", addr)
+	default:
+		return nil, websocket.ErrBadScheme
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	handshakeDone := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			netConn.Close()
+		case <-handshakeDone:
+		}
+	}()
+
+	conn, err := websocket.NewClient(config, netConn)
+	close(handshakeDone)
+	if err != nil {
+		netConn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	return conn, nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
 )
 
 const (
@@ -116,3 +118,103 @@ func TestWorkersTail_DeleteWorkersTail(t *testing.T) {
 	err = client.DeleteWorkersTail(context.Background(), AccountIdentifier(testAccountID), testScriptName, testTailID)
 	assert.NoError(t, err)
 }
+
+func TestWorkersTail_StreamWorkersTail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.Handle("/tail/"+testTailID, websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			assert.Equal(t, []string{"trace-v1"}, conn.Config().Protocol)
+
+			var filters map[string]interface{}
+			assert.NoError(t, websocket.JSON.Receive(conn, &filters))
+
+			_ = websocket.Message.Send(conn, []byte(`{
+				"outcome": "exception",
+				"scriptName": "this-is_my_script-01",
+				"logs": [{"message": ["handling request"], "level": "log", "timestamp": 1700000000000}],
+				"exceptions": [{"name": "Error", "message": "boom", "timestamp": 1700000000001}],
+				"eventTimestamp": 1700000000000,
+				"event": {"request": {"url": "https://example.com/", "method": "GET"}}
+			}`))
+
+			// Keep the connection open until the client goes away.
+			var msg []byte
+			_ = websocket.Message.Receive(conn, &msg)
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tail := WorkersTail{ID: testTailID, URL: "ws" + strings.TrimPrefix(server.URL, "http") + "/tail/" + testTailID}
+	stream, err := client.StreamWorkersTail(ctx, tail)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	select {
+	case event := <-stream.Events:
+		assert.Equal(t, "exception", event.Outcome)
+		assert.Equal(t, int64(1700000000000), event.EventTimestamp)
+		assert.Equal(t, []WorkersTailLog{{Message: []interface{}{"handling request"}, Level: "log", Timestamp: 1700000000000}}, event.Logs)
+		assert.Equal(t, []WorkersTailException{{Name: "Error", Message: "boom", Timestamp: 1700000000001}}, event.Exceptions)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for tail event")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-stream.Events:
+		assert.False(t, ok, "events should be closed after cancelling")
+		assert.ErrorIs(t, stream.Err(), context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for events to close")
+	}
+
+	_, err = client.StreamWorkersTail(context.Background(), WorkersTail{ID: testTailID})
+	assert.ErrorIs(t, err, ErrMissingTailURL)
+}
+
+func TestWorkersTail_StreamWorkersTailErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.Handle("/tail/"+testTailID, websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			var filters map[string]interface{}
+			assert.NoError(t, websocket.JSON.Receive(conn, &filters))
+			_ = websocket.Message.Send(conn, []byte(`not json`))
+		},
+	})
+	mux.Handle("/tail/expired", websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			var filters map[string]interface{}
+			assert.NoError(t, websocket.JSON.Receive(conn, &filters))
+		},
+	})
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	// A tail closed by the server ends the stream without an error.
+	stream, err := client.StreamWorkersTail(context.Background(), WorkersTail{ID: "expired", URL: wsURL + "/tail/expired"})
+	if assert.NoError(t, err) {
+		for range stream.Events {
+		}
+		assert.NoError(t, stream.Err())
+	}
+
+	stream, err = client.StreamWorkersTail(context.Background(), WorkersTail{ID: testTailID, URL: wsURL + "/tail/" + testTailID})
+	if assert.NoError(t, err) {
+		for range stream.Events {
+		}
+		assert.ErrorContains(t, stream.Err(), errUnmarshalError)
+	}
+
+	// Dialing is aborted when the context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.StreamWorkersTail(ctx, WorkersTail{ID: testTailID, URL: wsURL + "/tail/" + testTailID})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -566,6 +566,54 @@ func TestGetWorkersScriptSettings(t *testing.T) {
 	}
 }
 
+func TestGetWorkersScriptConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "bindings": [
+      {"name": "CACHE", "type": "kv_namespace", "namespace_id": "89f5f8fd93f94cb98473f6f421aa3b65"},
+      {"name": "ASSETS", "type": "r2_bucket", "bucket_name": "assets"},
+      {"name": "API_TOKEN", "type": "secret_text"}
+    ],
+    "compatibility_date": "2024-03-20",
+    "compatibility_flags": ["nodejs_compat"],
+    "logpush": true,
+    "tail_consumers": [{"service": "tail-worker"}],
+    "usage_model": "standard"
+  }
+}`)
+	})
+
+	want := WorkerScriptConfiguration{
+		Bindings: []WorkerBindingListItem{
+			{Name: "CACHE", Binding: WorkerKvNamespaceBinding{NamespaceID: "89f5f8fd93f94cb98473f6f421aa3b65"}},
+			{Name: "ASSETS", Binding: WorkerR2BucketBinding{BucketName: "assets"}},
+			{Name: "API_TOKEN", Binding: WorkerSecretTextBinding{}},
+		},
+		CompatibilityDate:  "2024-03-20",
+		CompatibilityFlags: []string{"nodejs_compat"},
+		Logpush:            BoolPtr(true),
+		TailConsumers:      []WorkersTailConsumer{{Service: "tail-worker"}},
+		UsageModel:         "standard",
+	}
+
+	_, err := client.GetWorkersScriptConfiguration(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingScriptName)
+
+	actual, err := client.GetWorkersScriptConfiguration(context.Background(), AccountIdentifier(testAccountID), "foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
 func TestUpdateWorkersScriptSettings(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,438 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrInvalidZarazWorkflow = errors.New("invalid Zaraz workflow, must be one of: realtime, preview")
+)
+
+// ZarazWorkflow controls whether changes to the Zaraz configuration are
+// applied immediately or only after being published.
+type ZarazWorkflow string
+
+const (
+	ZarazWorkflowRealtime ZarazWorkflow = "realtime"
+	ZarazWorkflowPreview  ZarazWorkflow = "preview"
+)
+
+// ZarazConfig is the Zaraz configuration of a zone. Updates replace the whole
+// configuration, so it should be read with GetZarazConfig and modified rather
+// than built from scratch. Fields that aren't modelled are kept in the Extra
+// field of each type so that they survive the round trip.
+type ZarazConfig struct {
+	DebugKey      string                   `json:"debugKey"`
+	ZarazVersion  int64                    `json:"zarazVersion"`
+	DataLayer     *bool                    `json:"dataLayer,omitempty"`
+	HistoryChange *bool                    `json:"historyChange,omitempty"`
+	Settings      ZarazConfigSettings      `json:"settings"`
+	Tools         map[string]ZarazTool     `json:"tools"`
+	Triggers      map[string]ZarazTrigger  `json:"triggers"`
+	Variables     map[string]ZarazVariable `json:"variables"`
+	Consent       map[string]interface{}   `json:"consent,omitempty"`
+	Analytics     *ZarazAnalytics          `json:"analytics,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazExtra holds the fields of a Zaraz configuration object that aren't
+// modelled by its type so that they are kept when the configuration is
+// updated.
+type ZarazExtra map[string]json.RawMessage
+
+// ZarazConfigSettings are the general settings of Zaraz.
+type ZarazConfigSettings struct {
+	AutoInjectScript    *bool        `json:"autoInjectScript,omitempty"`
+	InjectIframes       *bool        `json:"injectIframes,omitempty"`
+	Ecommerce           *bool        `json:"ecommerce,omitempty"`
+	HideQueryParams     *bool        `json:"hideQueryParams,omitempty"`
+	HideIPAddress       *bool        `json:"hideIPAddress,omitempty"`
+	HideUserAgent       *bool        `json:"hideUserAgent,omitempty"`
+	HideExternalReferer *bool        `json:"hideExternalReferer,omitempty"`
+	CookieDomain        string       `json:"cookieDomain,omitempty"`
+	InitPath            string       `json:"initPath,omitempty"`
+	ScriptPath          string       `json:"scriptPath,omitempty"`
+	TrackPath           string       `json:"trackPath,omitempty"`
+	EventsAPIPath       string       `json:"eventsApiPath,omitempty"`
+	McRootPath          string       `json:"mcRootPath,omitempty"`
+	ContextEnricher     *ZarazWorker `json:"contextEnricher,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazTool is a third-party tool, such as an analytics or marketing tag,
+// loaded by Zaraz.
+type ZarazTool struct {
+	Name             string                 `json:"name"`
+	Type             string                 `json:"type"`
+	Library          string                 `json:"library,omitempty"`
+	Component        string                 `json:"component"`
+	Enabled          *bool                  `json:"enabled,omitempty"`
+	Permissions      []string               `json:"permissions"`
+	Settings         map[string]interface{} `json:"settings"`
+	DefaultFields    map[string]interface{} `json:"defaultFields,omitempty"`
+	DefaultPurpose   string                 `json:"defaultPurpose,omitempty"`
+	BlockingTriggers []string               `json:"blockingTriggers,omitempty"`
+	NeoEvents        []ZarazAction          `json:"neoEvents,omitempty"`
+	Actions          map[string]ZarazAction `json:"actions,omitempty"`
+	Worker           *ZarazWorker           `json:"worker,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazAction is an action of a tool fired by triggers.
+type ZarazAction struct {
+	ActionType       string                 `json:"actionType"`
+	BlockingTriggers []string               `json:"blockingTriggers"`
+	Data             map[string]interface{} `json:"data"`
+	FiringTriggers   []string               `json:"firingTriggers"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazWorker references a Worker used by Zaraz.
+type ZarazWorker struct {
+	EscapedWorkerName string `json:"escapedWorkerName"`
+	WorkerTag         string `json:"workerTag"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazTrigger decides when tool actions are fired.
+type ZarazTrigger struct {
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description,omitempty"`
+	System       string                 `json:"system,omitempty"`
+	LoadRules    []ZarazTriggerRule     `json:"loadRules"`
+	ExcludeRules []ZarazTriggerRule     `json:"excludeRules"`
+	ClientRules  []interface{}          `json:"clientRules,omitempty"`
+	Settings     map[string]interface{} `json:"settings,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazTriggerRule is either a match rule, comparing Match with Value using
+// Op, or an action rule such as a click listener or timer.
+type ZarazTriggerRule struct {
+	ID       string                 `json:"id"`
+	Match    string                 `json:"match,omitempty"`
+	Op       string                 `json:"op,omitempty"`
+	Value    string                 `json:"value,omitempty"`
+	Action   string                 `json:"action,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazVariable is a value that can be referenced by tools. Type is one of
+// `string`, `secret` or `worker`.
+type ZarazVariable struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// ZarazAnalytics are the settings for Zaraz's own analytics.
+type ZarazAnalytics struct {
+	Enabled        *bool  `json:"enabled,omitempty"`
+	DefaultPurpose string `json:"defaultPurpose,omitempty"`
+	SessionExpTime int    `json:"sessionExpTime,omitempty"`
+
+	Extra ZarazExtra `json:"-"`
+}
+
+// The Zaraz configuration types decode and encode their Extra fields with
+// unmarshalWithExtra and marshalWithExtra.
+
+func (c *ZarazConfig) UnmarshalJSON(data []byte) error {
+	type plain ZarazConfig
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c ZarazConfig) MarshalJSON() ([]byte, error) {
+	type plain ZarazConfig
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+func (c *ZarazConfigSettings) UnmarshalJSON(data []byte) error {
+	type plain ZarazConfigSettings
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c ZarazConfigSettings) MarshalJSON() ([]byte, error) {
+	type plain ZarazConfigSettings
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+func (t *ZarazTool) UnmarshalJSON(data []byte) error {
+	type plain ZarazTool
+	return unmarshalWithExtra(data, (*plain)(t), &t.Extra)
+}
+
+func (t ZarazTool) MarshalJSON() ([]byte, error) {
+	type plain ZarazTool
+	return marshalWithExtra(plain(t), t.Extra)
+}
+
+func (a *ZarazAction) UnmarshalJSON(data []byte) error {
+	type plain ZarazAction
+	return unmarshalWithExtra(data, (*plain)(a), &a.Extra)
+}
+
+func (a ZarazAction) MarshalJSON() ([]byte, error) {
+	type plain ZarazAction
+	return marshalWithExtra(plain(a), a.Extra)
+}
+
+func (w *ZarazWorker) UnmarshalJSON(data []byte) error {
+	type plain ZarazWorker
+	return unmarshalWithExtra(data, (*plain)(w), &w.Extra)
+}
+
+func (w ZarazWorker) MarshalJSON() ([]byte, error) {
+	type plain ZarazWorker
+	return marshalWithExtra(plain(w), w.Extra)
+}
+
+func (t *ZarazTrigger) UnmarshalJSON(data []byte) error {
+	type plain ZarazTrigger
+	return unmarshalWithExtra(data, (*plain)(t), &t.Extra)
+}
+
+func (t ZarazTrigger) MarshalJSON() ([]byte, error) {
+	type plain ZarazTrigger
+	return marshalWithExtra(plain(t), t.Extra)
+}
+
+func (t *ZarazTriggerRule) UnmarshalJSON(data []byte) error {
+	type plain ZarazTriggerRule
+	return unmarshalWithExtra(data, (*plain)(t), &t.Extra)
+}
+
+func (t ZarazTriggerRule) MarshalJSON() ([]byte, error) {
+	type plain ZarazTriggerRule
+	return marshalWithExtra(plain(t), t.Extra)
+}
+
+func (v *ZarazVariable) UnmarshalJSON(data []byte) error {
+	type plain ZarazVariable
+	return unmarshalWithExtra(data, (*plain)(v), &v.Extra)
+}
+
+func (v ZarazVariable) MarshalJSON() ([]byte, error) {
+	type plain ZarazVariable
+	return marshalWithExtra(plain(v), v.Extra)
+}
+
+func (a *ZarazAnalytics) UnmarshalJSON(data []byte) error {
+	type plain ZarazAnalytics
+	return unmarshalWithExtra(data, (*plain)(a), &a.Extra)
+}
+
+func (a ZarazAnalytics) MarshalJSON() ([]byte, error) {
+	type plain ZarazAnalytics
+	return marshalWithExtra(plain(a), a.Extra)
+}
+
+// unmarshalWithExtra decodes data into v, a pointer to a Zaraz configuration
+// struct, and stores the fields of data that don't correspond to a field of
+// the struct in extra. The Zaraz types implement json.Unmarshaler by calling
+// it with v converted to a type without their methods, as
+// marshalWithExtra does for json.Marshaler.
+func unmarshalWithExtra(data []byte, v interface{}, extra *ZarazExtra) error {
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	var fields ZarazExtra
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		delete(fields, name)
+	}
+
+	if len(fields) > 0 {
+		*extra = fields
+	}
+
+	return nil
+}
+
+// marshalWithExtra encodes v, adding the fields of extra that v doesn't set.
+func marshalWithExtra(v interface{}, extra ZarazExtra) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for name, value := range extra {
+		if _, ok := fields[name]; !ok {
+			fields[name] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+type ZarazConfigResponse struct {
+	Result ZarazConfig `json:"result"`
+	Response
+}
+
+type ZarazWorkflowResponse struct {
+	Result ZarazWorkflow `json:"result"`
+	Response
+}
+
+// GetZarazConfig returns the Zaraz configuration of a zone. In the preview
+// workflow this includes unpublished changes.
+//
+// API reference: https://developers.cloudflare.com/api/operations/get-zones-zone_identifier-zaraz-config
+func (api *API) GetZarazConfig(ctx context.Context, rc *ResourceContainer) (ZarazConfig, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ZarazConfig{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ZarazConfig{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/zaraz/config", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ZarazConfig{}, err
+	}
+
+	return unmarshalZarazConfig(res)
+}
+
+// UpdateZarazConfig replaces the Zaraz configuration of a zone, including
+// its tools, triggers and variables. In the preview workflow the change only
+// goes live once published with PublishZarazConfig.
+//
+// API reference: https://developers.cloudflare.com/api/operations/put-zones-zone_identifier-zaraz-config
+func (api *API) UpdateZarazConfig(ctx context.Context, rc *ResourceContainer, config ZarazConfig) (ZarazConfig, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ZarazConfig{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ZarazConfig{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/zaraz/config", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, config)
+	if err != nil {
+		return ZarazConfig{}, err
+	}
+
+	return unmarshalZarazConfig(res)
+}
+
+// GetZarazWorkflow returns whether configuration changes of a zone are
+// applied in realtime or previewed before being published.
+//
+// API reference: https://developers.cloudflare.com/api/operations/get-zones-zone_identifier-zaraz-workflow
+func (api *API) GetZarazWorkflow(ctx context.Context, rc *ResourceContainer) (ZarazWorkflow, error) {
+	if rc.Level != ZoneRouteLevel {
+		return "", ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return "", ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/zaraz/workflow", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return unmarshalZarazWorkflow(res)
+}
+
+// UpdateZarazWorkflow sets whether configuration changes of a zone are
+// applied in realtime or previewed before being published.
+//
+// API reference: https://developers.cloudflare.com/api/operations/put-zones-zone_identifier-zaraz-workflow
+func (api *API) UpdateZarazWorkflow(ctx context.Context, rc *ResourceContainer, workflow ZarazWorkflow) (ZarazWorkflow, error) {
+	if rc.Level != ZoneRouteLevel {
+		return "", ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return "", ErrMissingZoneID
+	}
+
+	if workflow != ZarazWorkflowRealtime && workflow != ZarazWorkflowPreview {
+		return "", ErrInvalidZarazWorkflow
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/zaraz/workflow", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, workflow)
+	if err != nil {
+		return "", err
+	}
+
+	return unmarshalZarazWorkflow(res)
+}
+
+// PublishZarazConfig publishes the previewed Zaraz configuration of a zone,
+// recording the description in the configuration history.
+//
+// API reference: https://developers.cloudflare.com/api/operations/post-zones-zone_identifier-zaraz-publish
+func (api *API) PublishZarazConfig(ctx context.Context, rc *ResourceContainer, description string) error {
+	if rc.Level != ZoneRouteLevel {
+		return ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/zaraz/publish", rc.Identifier)
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, description)
+
+	return err
+}
+
+func unmarshalZarazConfig(res []byte) (ZarazConfig, error) {
+	var r ZarazConfigResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return ZarazConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+func unmarshalZarazWorkflow(res []byte) (ZarazWorkflow, error) {
+	var r ZarazWorkflowResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
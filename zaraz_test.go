@@ -0,0 +1,207 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testZarazConfigJSON = `{
+  "debugKey": "cheese",
+  "zarazVersion": 44,
+  "dataLayer": true,
+  "settings": {"autoInjectScript": true},
+  "tools": {
+    "PBQr": {
+      "name": "Google Analytics 4",
+      "type": "component",
+      "component": "google-analytics",
+      "enabled": true,
+      "permissions": ["access_client_kv"],
+      "settings": {"tid": "G-XXXXXXXXXX"},
+      "neoEvents": [
+        {"actionType": "pageview", "blockingTriggers": [], "data": {}, "firingTriggers": ["Pageview"]}
+      ]
+    }
+  },
+  "triggers": {
+    "Pageview": {
+      "name": "Pageview",
+      "system": "pageload",
+      "loadRules": [],
+      "excludeRules": []
+    }
+  },
+  "variables": {
+    "Xq9a": {"name": "measurement-secret", "type": "secret", "value": "shh"}
+  }
+}`
+
+func testZarazConfig() ZarazConfig {
+	return ZarazConfig{
+		DebugKey:     "cheese",
+		ZarazVersion: 44,
+		DataLayer:    BoolPtr(true),
+		Settings:     ZarazConfigSettings{AutoInjectScript: BoolPtr(true)},
+		Tools: map[string]ZarazTool{
+			"PBQr": {
+				Name:        "Google Analytics 4",
+				Type:        "component",
+				Component:   "google-analytics",
+				Enabled:     BoolPtr(true),
+				Permissions: []string{"access_client_kv"},
+				Settings:    map[string]interface{}{"tid": "G-XXXXXXXXXX"},
+				NeoEvents: []ZarazAction{{
+					ActionType:       "pageview",
+					BlockingTriggers: []string{},
+					Data:             map[string]interface{}{},
+					FiringTriggers:   []string{"Pageview"},
+				}},
+			},
+		},
+		Triggers: map[string]ZarazTrigger{
+			"Pageview": {
+				Name:         "Pageview",
+				System:       "pageload",
+				LoadRules:    []ZarazTriggerRule{},
+				ExcludeRules: []ZarazTriggerRule{},
+			},
+		},
+		Variables: map[string]ZarazVariable{
+			"Xq9a": {Name: "measurement-secret", Type: "secret", Value: "shh"},
+		},
+	}
+}
+
+func TestGetZarazConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/zaraz/config", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testZarazConfigJSON)
+	})
+
+	_, err := client.GetZarazConfig(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	actual, err := client.GetZarazConfig(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, testZarazConfig(), actual)
+	}
+}
+
+func TestUpdateZarazConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/zaraz/config", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, testZarazConfigJSON, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testZarazConfigJSON)
+	})
+
+	actual, err := client.UpdateZarazConfig(context.Background(), ZoneIdentifier(testZoneID), testZarazConfig())
+	if assert.NoError(t, err) {
+		assert.Equal(t, testZarazConfig(), actual)
+	}
+}
+
+func TestUpdateZarazWorkflow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/zaraz/workflow", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, `"preview"`, string(body))
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "preview"}`)
+	})
+
+	_, err := client.UpdateZarazWorkflow(context.Background(), ZoneIdentifier(testZoneID), "staged")
+	assert.ErrorIs(t, err, ErrInvalidZarazWorkflow)
+
+	actual, err := client.UpdateZarazWorkflow(context.Background(), ZoneIdentifier(testZoneID), ZarazWorkflowPreview)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZarazWorkflowPreview, actual)
+	}
+
+	actual, err = client.GetZarazWorkflow(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZarazWorkflowPreview, actual)
+	}
+}
+
+func TestPublishZarazConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/zaraz/publish", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `"Add GA4"`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "Config has been published successfully"}`)
+	})
+
+	err := client.PublishZarazConfig(context.Background(), ZoneIdentifier(testZoneID), "Add GA4")
+	assert.NoError(t, err)
+}
+
+func TestUpdateZarazConfig_KeepsUnknownFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const config = `{
+	  "debugKey": "cheese",
+	  "zarazVersion": 44,
+	  "settings": {"autoInjectScript": true, "ecommerce": false, "newSetting": {"enabled": true}},
+	  "tools": {
+	    "PBQr": {
+	      "name": "Google Analytics 4",
+	      "type": "component",
+	      "component": "google-analytics",
+	      "mode": {"light": false, "cloud": true},
+	      "vendorName": "Google",
+	      "permissions": [],
+	      "settings": {}
+	    }
+	  },
+	  "triggers": {},
+	  "variables": {},
+	  "historyChange": true,
+	  "dataLayer": true
+	}`
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/zaraz/config", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, strings.Replace(config, `"debugKey": "cheese"`, `"debugKey": "crackers"`, 1), string(body))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, config)
+	})
+
+	actual, err := client.GetZarazConfig(context.Background(), ZoneIdentifier(testZoneID))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"light": false, "cloud": true}`, string(actual.Tools["PBQr"].Extra["mode"]))
+
+	actual.DebugKey = "crackers"
+	_, err = client.UpdateZarazConfig(context.Background(), ZoneIdentifier(testZoneID), actual)
+	assert.NoError(t, err)
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,6 +21,19 @@ import (
 var (
 	// ErrMissingSettingName is for when setting name is required but missing.
 	ErrMissingSettingName = errors.New("zone setting name required but missing")
+
+	// ErrZoneHasDependents is for when a zone can't be deleted because
+	// resources still depend on it.
+	ErrZoneHasDependents = errors.New("zone has dependent resources")
+
+	ErrInvalidZoneSecurityLevel    = errors.New("security level must be off, essentially_off, low, medium, high or under_attack")
+	ErrInvalidChallengeTTL         = errors.New("invalid challenge TTL")
+	ErrInvalidPolish               = errors.New("polish must be off, lossless or lossy")
+	ErrInvalidHSTSMaxAge           = errors.New("HSTS max age must not be negative")
+	ErrInvalidHSTSPreload          = errors.New("HSTS preload requires include subdomains and a max age of at least 31536000 seconds")
+	ErrInvalidAPOHostname          = errors.New("automatic platform optimization hostnames must not be empty")
+	ErrInvalidAPOWordPressPlugin   = errors.New("automatic platform optimization WordPress plugin requires WordPress")
+	ErrInvalidOriginMaxHTTPVersion = errors.New("origin max HTTP version must be 1 or 2")
 )
 
 // Owner describes the resource owner.
@@ -353,20 +369,116 @@ func (api *API) CreateZone(ctx context.Context, name string, jumpstart bool, acc
 	return r.Result, nil
 }
 
+// ZoneCreateResult is the outcome of creating a single zone with CreateZones.
+type ZoneCreateResult struct {
+	Params  ZoneCreateParams
+	Success bool
+	Zone    Zone
+	Err     error
+}
+
+// defaultCreateZonesConcurrency is the number of zones created at once when
+// CreateZones isn't given a limit.
+const defaultCreateZonesConcurrency = 10
+
+// CreateZones creates many zones concurrently, with at most concurrency
+// creates in flight; a concurrency below 1 uses a default of 10. It returns
+// one result per zone in the order of params, so failed zones can be retried
+// on their own. Zones that weren't started before ctx is cancelled fail with
+// the context's error.
+//
+// API reference: https://api.cloudflare.com/#zone-create-a-zone
+func (api *API) CreateZones(ctx context.Context, params []ZoneCreateParams, concurrency int) []ZoneCreateResult {
+	if concurrency < 1 {
+		concurrency = defaultCreateZonesConcurrency
+	}
+	if concurrency > len(params) {
+		concurrency = len(params)
+	}
+
+	results := make([]ZoneCreateResult, len(params))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = api.createZoneResult(ctx, params[i])
+			}
+		}()
+	}
+
+	for i := range params {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func (api *API) createZoneResult(ctx context.Context, params ZoneCreateParams) ZoneCreateResult {
+	result := ZoneCreateResult{Params: params}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	var account Account
+	if params.Account != nil {
+		account = *params.Account
+	}
+
+	zone, err := api.CreateZone(ctx, params.Name, params.JumpStart, account, params.Type)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Success = true
+	result.Zone = zone
+	return result
+}
+
+// ZoneActivationCheckResult contains the zone that had an activation check
+// initiated.
+type ZoneActivationCheckResult struct {
+	ID     string `json:"id"`
+	Status string `json:"status,omitempty"`
+}
+
+// ZoneActivationCheckResponse represents the response from the zone
+// activation check endpoint.
+type ZoneActivationCheckResponse struct {
+	Response
+	Result ZoneActivationCheckResult `json:"result"`
+}
+
 // ZoneActivationCheck initiates another zone activation check for newly-created zones.
 //
-// API reference: https://api.cloudflare.com/#zone-initiate-another-zone-activation-check
-func (api *API) ZoneActivationCheck(ctx context.Context, zoneID string) (Response, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodPut, "/zones/"+zoneID+"/activation_check", nil)
+// API reference: https://developers.cloudflare.com/api/operations/put-zones-zone_id-activation_check
+func (api *API) ZoneActivationCheck(ctx context.Context, rc *ResourceContainer) (ZoneActivationCheckResult, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ZoneActivationCheckResult{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ZoneActivationCheckResult{}, ErrMissingZoneID
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/activation_check", rc.Identifier), nil)
 	if err != nil {
-		return Response{}, err
+		return ZoneActivationCheckResult{}, err
 	}
-	var r Response
+
+	var r ZoneActivationCheckResponse
 	err = json.Unmarshal(res, &r)
 	if err != nil {
-		return Response{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		return ZoneActivationCheckResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
-	return r, nil
+	return r.Result, nil
 }
 
 // ListZones lists zones on an account. Optionally takes a list of zone names
@@ -700,6 +812,11 @@ func (api *API) DeleteZone(ctx context.Context, zoneID string) (ZoneID, error) {
 	if err != nil {
 		return ZoneID{}, err
 	}
+
+	if api.zoneCache != nil {
+		api.zoneCache.invalidate(zoneID)
+	}
+
 	var r ZoneIDResponse
 	err = json.Unmarshal(res, &r)
 	if err != nil {
@@ -708,6 +825,136 @@ func (api *API) DeleteZone(ctx context.Context, zoneID string) (ZoneID, error) {
 	return r.Result, nil
 }
 
+// ZoneDeleteOptions controls the dependency checks made by
+// DeleteZoneWithOptions before a zone is deleted. All checks are made unless
+// they are skipped.
+type ZoneDeleteOptions struct {
+	SkipCustomHostnames      bool
+	SkipLoadBalancers        bool
+	SkipSpectrumApplications bool
+
+	// Force deletes the zone without making any of the checks.
+	Force bool
+}
+
+// ZoneDependent is a resource that depends on a zone.
+type ZoneDependent struct {
+	// Type is one of `custom_hostname`, `load_balancer` or
+	// `spectrum_application`.
+	Type string
+	ID   string
+	Name string
+
+	// Count is set instead of ID and Name for resources that are only
+	// counted, such as custom hostnames of which a zone can have millions.
+	Count int
+}
+
+// ZoneDependentsError is returned by DeleteZoneWithOptions when resources
+// still depend on the zone. It matches ErrZoneHasDependents.
+type ZoneDependentsError struct {
+	ZoneID     string
+	Dependents []ZoneDependent
+}
+
+func (e *ZoneDependentsError) Error() string {
+	names := make([]string, 0, len(e.Dependents))
+	for _, d := range e.Dependents {
+		if d.Count > 0 {
+			names = append(names, fmt.Sprintf("%d %s", d.Count, d.Type))
+			continue
+		}
+		names = append(names, d.Type+" "+d.Name)
+	}
+
+	return fmt.Sprintf("%s: zone %s is used by %s", ErrZoneHasDependents, e.ZoneID, strings.Join(names, ", "))
+}
+
+func (e *ZoneDependentsError) Is(target error) bool {
+	return target == ErrZoneHasDependents
+}
+
+// DeleteZoneWithOptions deletes the given zone after checking that none of
+// the kinds of resources that aren't skipped still depend on it. When dependents are
+// found the zone is kept and a *ZoneDependentsError listing them is
+// returned, unless Force is set.
+//
+// API reference: https://api.cloudflare.com/#zone-delete-a-zone
+func (api *API) DeleteZoneWithOptions(ctx context.Context, zoneID string, opts ZoneDeleteOptions) (ZoneID, error) {
+	if zoneID == "" {
+		return ZoneID{}, ErrMissingZoneID
+	}
+
+	if !opts.Force {
+		dependents, err := api.zoneDependents(ctx, zoneID, opts)
+		if err != nil {
+			return ZoneID{}, fmt.Errorf("failed to check zone dependents: %w", err)
+		}
+
+		if len(dependents) > 0 {
+			return ZoneID{}, &ZoneDependentsError{ZoneID: zoneID, Dependents: dependents}
+		}
+	}
+
+	return api.DeleteZone(ctx, zoneID)
+}
+
+// zoneDependents returns the resources not skipped by opts that depend on the
+// zone.
+func (api *API) zoneDependents(ctx context.Context, zoneID string, opts ZoneDeleteOptions) ([]ZoneDependent, error) {
+	var dependents []ZoneDependent
+
+	if !opts.SkipCustomHostnames {
+		count, err := api.customHostnameCount(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			dependents = append(dependents, ZoneDependent{Type: "custom_hostname", Count: count})
+		}
+	}
+
+	if !opts.SkipLoadBalancers {
+		lbs, err := api.ListLoadBalancers(ctx, ZoneIdentifier(zoneID), ListLoadBalancerParams{})
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range lbs {
+			dependents = append(dependents, ZoneDependent{Type: "load_balancer", ID: lb.ID, Name: lb.Name})
+		}
+	}
+
+	if !opts.SkipSpectrumApplications {
+		apps, err := api.SpectrumApplications(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			dependents = append(dependents, ZoneDependent{Type: "spectrum_application", ID: app.ID, Name: app.DNS.Name})
+		}
+	}
+
+	return dependents, nil
+}
+
+// customHostnameCount returns the number of custom hostnames in the zone
+// without listing them.
+func (api *API) customHostnameCount(ctx context.Context, zoneID string) (int, error) {
+	uri := fmt.Sprintf("/zones/%s/custom_hostnames?per_page=1", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var r CustomHostnameListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.ResultInfo.Total, nil
+}
+
 // AvailableZoneRatePlans returns information about all plans available to the specified zone.
 //
 // API reference: https://api.cloudflare.com/#zone-plan-available-plans
@@ -952,6 +1199,43 @@ func (api *API) GetZoneSetting(ctx context.Context, rc *ResourceContainer, param
 	return r.Result, nil
 }
 
+// ZoneSettingMeta is a zone setting's value together with whether it can be
+// changed and when it was last modified.
+type ZoneSettingMeta struct {
+	ID       string
+	Value    interface{}
+	Editable bool
+	// ModifiedOn is nil for settings that have never been modified.
+	ModifiedOn *time.Time
+}
+
+// ZoneSettingWithMeta returns the value of the named zone setting along with
+// its Editable flag and last modification time, for example to report when a
+// setting drifted from its desired value.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
+func (api *API) ZoneSettingWithMeta(ctx context.Context, rc *ResourceContainer, name string) (ZoneSettingMeta, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: name})
+	if err != nil {
+		return ZoneSettingMeta{}, err
+	}
+
+	meta := ZoneSettingMeta{
+		ID:       setting.ID,
+		Value:    setting.Value,
+		Editable: setting.Editable,
+	}
+	if setting.ModifiedOn != "" {
+		modifiedOn, err := time.Parse(time.RFC3339Nano, setting.ModifiedOn)
+		if err != nil {
+			return ZoneSettingMeta{}, fmt.Errorf("unexpected modified_on %q for zone setting %s: %w", setting.ModifiedOn, setting.ID, err)
+		}
+		meta.ModifiedOn = &modifiedOn
+	}
+
+	return meta, nil
+}
+
 // UpdateZoneSetting updates the specified setting for a given zone.
 //
 // API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
@@ -984,6 +1268,741 @@ func (api *API) UpdateZoneSetting(ctx context.Context, rc *ResourceContainer, pa
 	return response.Result, nil
 }
 
+// getZoneSettingToggle returns whether an on/off zone setting is enabled.
+func (api *API) getZoneSettingToggle(ctx context.Context, rc *ResourceContainer, name string) (bool, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: name})
+	if err != nil {
+		return false, err
+	}
+
+	return zoneSettingToggleValue(setting)
+}
+
+// setZoneSettingToggle turns an on/off zone setting on or off, returning the
+// resulting state.
+func (api *API) setZoneSettingToggle(ctx context.Context, rc *ResourceContainer, name string, on bool) (bool, error) {
+	value := "off"
+	if on {
+		value = "on"
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: name, Value: value})
+	if err != nil {
+		return false, err
+	}
+
+	return zoneSettingToggleValue(setting)
+}
+
+func zoneSettingToggleValue(setting ZoneSetting) (bool, error) {
+	switch setting.Value {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+
+	return false, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+}
+
+// GetAlwaysOnline reports whether Always Online, which serves pages from the
+// Internet Archive when the origin is unreachable, is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-always-online-setting
+func (api *API) GetAlwaysOnline(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "always_online")
+}
+
+// SetAlwaysOnline enables or disables Always Online for the zone, returning
+// the resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-always-online-setting
+func (api *API) SetAlwaysOnline(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "always_online", on)
+}
+
+// GetOfflineTempErrors reports whether Always Online also serves archived
+// pages when the origin returns a temporary error, rather than only when it
+// is unreachable.
+func (api *API) GetOfflineTempErrors(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "offline_temp_errors")
+}
+
+// AlwaysOnlineCrawlStatus describes which of a zone's pages have been archived
+// for Always Online.
+type AlwaysOnlineCrawlStatus struct {
+	ArchivedURLs  []string   `json:"archived_urls"`
+	LastCrawledOn *time.Time `json:"last_crawled_on,omitempty"`
+}
+
+// AlwaysOnlineCrawlStatusResponse is the API response for the Always Online
+// crawl status of a zone.
+type AlwaysOnlineCrawlStatusResponse struct {
+	Response
+	Result AlwaysOnlineCrawlStatus `json:"result"`
+}
+
+// GetAlwaysOnlineCrawlStatus returns the URLs of the zone that have been
+// archived for Always Online, to check its coverage of the zone.
+func (api *API) GetAlwaysOnlineCrawlStatus(ctx context.Context, rc *ResourceContainer) (AlwaysOnlineCrawlStatus, error) {
+	if rc.Level != ZoneRouteLevel {
+		return AlwaysOnlineCrawlStatus{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AlwaysOnlineCrawlStatus{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/always_online", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AlwaysOnlineCrawlStatus{}, err
+	}
+
+	var r AlwaysOnlineCrawlStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AlwaysOnlineCrawlStatus{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetHTTP3 reports whether HTTP/3 over QUIC is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-http3-setting
+func (api *API) GetHTTP3(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "http3")
+}
+
+// SetHTTP3 enables or disables HTTP/3 for the zone, returning the resulting
+// state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-http3-setting
+func (api *API) SetHTTP3(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "http3", on)
+}
+
+// Get0RTT reports whether 0-RTT session resumption is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-0-rtt-session-resumption-setting
+func (api *API) Get0RTT(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "0rtt")
+}
+
+// Set0RTT enables or disables 0-RTT session resumption for the zone,
+// returning the resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-0-rtt-session-resumption-setting
+func (api *API) Set0RTT(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "0rtt", on)
+}
+
+// GetEarlyHints reports whether Early Hints (103 responses) are enabled for
+// the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-early-hints-setting
+func (api *API) GetEarlyHints(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "early_hints")
+}
+
+// SetEarlyHints enables or disables Early Hints for the zone, returning the
+// resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-early-hints-setting
+func (api *API) SetEarlyHints(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "early_hints", on)
+}
+
+// ZoneSecurityLevel is the `security_level` zone setting, which sets how
+// suspicious a visitor's IP reputation has to be for them to be challenged.
+type ZoneSecurityLevel string
+
+const (
+	ZoneSecurityLevelOff            ZoneSecurityLevel = "off"
+	ZoneSecurityLevelEssentiallyOff ZoneSecurityLevel = "essentially_off"
+	ZoneSecurityLevelLow            ZoneSecurityLevel = "low"
+	ZoneSecurityLevelMedium         ZoneSecurityLevel = "medium"
+	ZoneSecurityLevelHigh           ZoneSecurityLevel = "high"
+	// ZoneSecurityLevelUnderAttack challenges every visitor.
+	ZoneSecurityLevelUnderAttack ZoneSecurityLevel = "under_attack"
+)
+
+// ChallengeTTLValues are the number of seconds a visitor who passed a
+// challenge can be allowed through for.
+var ChallengeTTLValues = []int{300, 900, 1800, 2700, 3600, 7200, 10800, 14400, 28800, 57600, 86400, 604800, 2592000, 31536000}
+
+// GetSecurityLevel returns the security level of the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-security-level-setting
+func (api *API) GetSecurityLevel(ctx context.Context, rc *ResourceContainer) (ZoneSecurityLevel, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "security_level"})
+	if err != nil {
+		return "", err
+	}
+
+	return zoneSecurityLevelValue(setting)
+}
+
+// SetSecurityLevel changes the security level of the zone, returning the
+// resulting level.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-security-level-setting
+func (api *API) SetSecurityLevel(ctx context.Context, rc *ResourceContainer, level ZoneSecurityLevel) (ZoneSecurityLevel, error) {
+	switch level {
+	case ZoneSecurityLevelOff, ZoneSecurityLevelEssentiallyOff, ZoneSecurityLevelLow,
+		ZoneSecurityLevelMedium, ZoneSecurityLevelHigh, ZoneSecurityLevelUnderAttack:
+	default:
+		return "", ErrInvalidZoneSecurityLevel
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "security_level", Value: string(level)})
+	if err != nil {
+		return "", err
+	}
+
+	return zoneSecurityLevelValue(setting)
+}
+
+func zoneSecurityLevelValue(setting ZoneSetting) (ZoneSecurityLevel, error) {
+	value, ok := setting.Value.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return ZoneSecurityLevel(value), nil
+}
+
+// GetChallengeTTL returns the number of seconds a visitor who passed a
+// challenge is allowed through for.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-challenge-ttl-setting
+func (api *API) GetChallengeTTL(ctx context.Context, rc *ResourceContainer) (int, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "challenge_ttl"})
+	if err != nil {
+		return 0, err
+	}
+
+	return challengeTTLValue(setting)
+}
+
+// SetChallengeTTL changes the number of seconds a visitor who passed a
+// challenge is allowed through for, returning the resulting TTL. The TTL must
+// be one of ChallengeTTLValues.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-challenge-ttl-setting
+func (api *API) SetChallengeTTL(ctx context.Context, rc *ResourceContainer, seconds int) (int, error) {
+	valid := false
+	for _, v := range ChallengeTTLValues {
+		if v == seconds {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return 0, fmt.Errorf("%w: %d seconds", ErrInvalidChallengeTTL, seconds)
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "challenge_ttl", Value: seconds})
+	if err != nil {
+		return 0, err
+	}
+
+	return challengeTTLValue(setting)
+}
+
+func challengeTTLValue(setting ZoneSetting) (int, error) {
+	value, ok := setting.Value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return int(value), nil
+}
+
+// GetPolish returns the Polish image optimization mode of the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-polish-setting
+func (api *API) GetPolish(ctx context.Context, rc *ResourceContainer) (Polish, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "polish"})
+	if err != nil {
+		return 0, err
+	}
+
+	return polishValue(setting)
+}
+
+// SetPolish changes the Polish image optimization mode of the zone,
+// returning the resulting mode.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-polish-setting
+func (api *API) SetPolish(ctx context.Context, rc *ResourceContainer, polish Polish) (Polish, error) {
+	switch polish {
+	case PolishOff, PolishLossless, PolishLossy:
+	default:
+		return 0, ErrInvalidPolish
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "polish", Value: polish.String()})
+	if err != nil {
+		return 0, err
+	}
+
+	return polishValue(setting)
+}
+
+func polishValue(setting ZoneSetting) (Polish, error) {
+	value, ok := setting.Value.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	polish, err := PolishFromString(value)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return *polish, nil
+}
+
+// GetMirage reports whether Mirage image optimization for mobile visitors is
+// enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-mirage-setting
+func (api *API) GetMirage(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "mirage")
+}
+
+// SetMirage enables or disables Mirage for the zone, returning the resulting
+// state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-mirage-setting
+func (api *API) SetMirage(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "mirage", on)
+}
+
+// GetRocketLoader reports whether Rocket Loader, which defers loading of
+// JavaScript until after rendering, is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-rocket_loader-setting
+func (api *API) GetRocketLoader(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "rocket_loader")
+}
+
+// SetRocketLoader enables or disables Rocket Loader for the zone, returning
+// the resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-rocket_loader-setting
+func (api *API) SetRocketLoader(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "rocket_loader", on)
+}
+
+// ZoneMinify is the `minify` zone setting, which removes unnecessary
+// characters from HTML, CSS and JavaScript responses.
+type ZoneMinify struct {
+	HTML bool
+	CSS  bool
+	JS   bool
+}
+
+// GetMinify returns which content types are minified for the zone.
+//
+// Deprecated: Auto Minify is no longer offered for new zones but remains
+// configurable on older ones.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-minify-setting
+func (api *API) GetMinify(ctx context.Context, rc *ResourceContainer) (ZoneMinify, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "minify"})
+	if err != nil {
+		return ZoneMinify{}, err
+	}
+
+	return zoneMinifyValue(setting)
+}
+
+// SetMinify changes which content types are minified for the zone, returning
+// the resulting configuration.
+//
+// Deprecated: Auto Minify is no longer offered for new zones but remains
+// configurable on older ones.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-minify-setting
+func (api *API) SetMinify(ctx context.Context, rc *ResourceContainer, minify ZoneMinify) (ZoneMinify, error) {
+	toggle := func(on bool) string {
+		if on {
+			return "on"
+		}
+		return "off"
+	}
+
+	value := map[string]string{
+		"html": toggle(minify.HTML),
+		"css":  toggle(minify.CSS),
+		"js":   toggle(minify.JS),
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "minify", Value: value})
+	if err != nil {
+		return ZoneMinify{}, err
+	}
+
+	return zoneMinifyValue(setting)
+}
+
+func zoneMinifyValue(setting ZoneSetting) (ZoneMinify, error) {
+	value, ok := setting.Value.(map[string]interface{})
+	if !ok {
+		return ZoneMinify{}, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	var minify ZoneMinify
+	for key, target := range map[string]*bool{"html": &minify.HTML, "css": &minify.CSS, "js": &minify.JS} {
+		on, err := zoneSettingToggleValue(ZoneSetting{ID: setting.ID + "." + key, Value: value[key]})
+		if err != nil {
+			return ZoneMinify{}, err
+		}
+		*target = on
+	}
+
+	return minify, nil
+}
+
+// SecurityHeader is the `security_header` zone setting, which adds the
+// HTTP Strict Transport Security header to responses.
+type SecurityHeader struct {
+	StrictTransportSecurity StrictTransportSecurity `json:"strict_transport_security"`
+}
+
+// StrictTransportSecurity configures the Strict-Transport-Security header.
+// Browsers refuse plain HTTP connections to the site for MaxAge seconds after
+// seeing it, so enabling it can't be undone quickly.
+type StrictTransportSecurity struct {
+	Enabled           bool `json:"enabled"`
+	MaxAge            int  `json:"max_age"`
+	IncludeSubdomains bool `json:"include_subdomains"`
+	Preload           bool `json:"preload"`
+
+	// Nosniff also sends `X-Content-Type-Options: nosniff`.
+	Nosniff bool `json:"nosniff"`
+}
+
+// hstsPreloadMinMaxAge is the shortest max-age accepted by the HSTS preload
+// list.
+const hstsPreloadMinMaxAge = 31536000
+
+// GetSecurityHeader returns the HSTS configuration of the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-security-header-(-hsts)-setting
+func (api *API) GetSecurityHeader(ctx context.Context, rc *ResourceContainer) (SecurityHeader, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "security_header"})
+	if err != nil {
+		return SecurityHeader{}, err
+	}
+
+	return securityHeaderValue(setting)
+}
+
+// UpdateSecurityHeader changes the HSTS configuration of the zone, returning
+// the resulting configuration. Preloading requires IncludeSubdomains and a
+// MaxAge of at least a year.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-security-header-(-hsts)-setting
+func (api *API) UpdateSecurityHeader(ctx context.Context, rc *ResourceContainer, params SecurityHeader) (SecurityHeader, error) {
+	hsts := params.StrictTransportSecurity
+	if hsts.MaxAge < 0 {
+		return SecurityHeader{}, ErrInvalidHSTSMaxAge
+	}
+
+	if hsts.Preload && (!hsts.IncludeSubdomains || hsts.MaxAge < hstsPreloadMinMaxAge) {
+		return SecurityHeader{}, ErrInvalidHSTSPreload
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "security_header", Value: params})
+	if err != nil {
+		return SecurityHeader{}, err
+	}
+
+	return securityHeaderValue(setting)
+}
+
+func securityHeaderValue(setting ZoneSetting) (SecurityHeader, error) {
+	value, err := json.Marshal(setting.Value)
+	if err != nil {
+		return SecurityHeader{}, err
+	}
+
+	var header SecurityHeader
+	if err := json.Unmarshal(value, &header); err != nil {
+		return SecurityHeader{}, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return header, nil
+}
+
+type zoneNELValue struct {
+	Enabled bool `json:"enabled"`
+}
+
+// GetNEL reports whether Network Error Logging, which has browsers report
+// failed connections to Cloudflare, is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-network-error-logging-setting
+func (api *API) GetNEL(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "nel"})
+	if err != nil {
+		return false, err
+	}
+
+	return zoneNELSettingValue(setting)
+}
+
+// SetNEL enables or disables Network Error Logging for the zone, returning
+// the resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-network-error-logging-setting
+func (api *API) SetNEL(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "nel", Value: zoneNELValue{Enabled: on}})
+	if err != nil {
+		return false, err
+	}
+
+	return zoneNELSettingValue(setting)
+}
+
+func zoneNELSettingValue(setting ZoneSetting) (bool, error) {
+	value, ok := setting.Value.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	enabled, ok := value["enabled"].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return enabled, nil
+}
+
+// GetFonts reports whether Cloudflare Fonts, which rewrites Google Fonts to be
+// served from the zone's own domain, is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-fonts-setting
+func (api *API) GetFonts(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "fonts")
+}
+
+// SetFonts enables or disables Cloudflare Fonts for the zone, returning the
+// resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-fonts-setting
+func (api *API) SetFonts(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "fonts", on)
+}
+
+// GetSpeedBrain reports whether Speed Brain, which prefetches likely next
+// navigations using the Speculation Rules API, is enabled for the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-speed-brain-setting
+func (api *API) GetSpeedBrain(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "speed_brain")
+}
+
+// SetSpeedBrain enables or disables Speed Brain for the zone, returning the
+// resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-speed-brain-setting
+func (api *API) SetSpeedBrain(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "speed_brain", on)
+}
+
+// AutomaticPlatformOptimization is the `automatic_platform_optimization` zone
+// setting, which caches HTML of WordPress sites at the edge.
+type AutomaticPlatformOptimization struct {
+	Enabled bool `json:"enabled"`
+	// CF marks the zone as proxied through Cloudflare.
+	CF              bool `json:"cf"`
+	WordPress       bool `json:"wordpress"`
+	WordPressPlugin bool `json:"wp_plugin"`
+	// Hostnames limits APO to the given hostnames. When empty it applies to
+	// the whole zone.
+	Hostnames         []string `json:"hostnames"`
+	CacheByDeviceType bool     `json:"cache_by_device_type"`
+}
+
+// GetAutomaticPlatformOptimization returns the APO configuration of the zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-automatic-platform-optimization-for-wordpress-setting
+func (api *API) GetAutomaticPlatformOptimization(ctx context.Context, rc *ResourceContainer) (AutomaticPlatformOptimization, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "automatic_platform_optimization"})
+	if err != nil {
+		return AutomaticPlatformOptimization{}, err
+	}
+
+	return automaticPlatformOptimizationValue(setting)
+}
+
+// SetAutomaticPlatformOptimization changes the APO configuration of the zone,
+// returning the resulting configuration.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-automatic-platform-optimization-for-wordpress-setting
+func (api *API) SetAutomaticPlatformOptimization(ctx context.Context, rc *ResourceContainer, apo AutomaticPlatformOptimization) (AutomaticPlatformOptimization, error) {
+	for _, hostname := range apo.Hostnames {
+		if strings.TrimSpace(hostname) == "" {
+			return AutomaticPlatformOptimization{}, ErrInvalidAPOHostname
+		}
+	}
+
+	if apo.WordPressPlugin && !apo.WordPress {
+		return AutomaticPlatformOptimization{}, ErrInvalidAPOWordPressPlugin
+	}
+
+	if apo.Hostnames == nil {
+		apo.Hostnames = []string{}
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "automatic_platform_optimization", Value: apo})
+	if err != nil {
+		return AutomaticPlatformOptimization{}, err
+	}
+
+	return automaticPlatformOptimizationValue(setting)
+}
+
+func automaticPlatformOptimizationValue(setting ZoneSetting) (AutomaticPlatformOptimization, error) {
+	value, err := json.Marshal(setting.Value)
+	if err != nil {
+		return AutomaticPlatformOptimization{}, err
+	}
+
+	var apo AutomaticPlatformOptimization
+	if err := json.Unmarshal(value, &apo); err != nil {
+		return AutomaticPlatformOptimization{}, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return apo, nil
+}
+
+// GetOriginMaxHTTPVersion returns the highest HTTP version, 1 or 2, used for
+// connections to the zone's origin.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-origin-max-http-version-setting
+func (api *API) GetOriginMaxHTTPVersion(ctx context.Context, rc *ResourceContainer) (int, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: "origin_max_http_version"})
+	if err != nil {
+		return 0, err
+	}
+
+	return originMaxHTTPVersionValue(setting)
+}
+
+// SetOriginMaxHTTPVersion changes the highest HTTP version used for
+// connections to the zone's origin, returning the resulting version. Setting
+// it to 1 pins origin connections to HTTP/1.1.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-origin-max-http-version-setting
+func (api *API) SetOriginMaxHTTPVersion(ctx context.Context, rc *ResourceContainer, version int) (int, error) {
+	if version != 1 && version != 2 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidOriginMaxHTTPVersion, version)
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: "origin_max_http_version", Value: strconv.Itoa(version)})
+	if err != nil {
+		return 0, err
+	}
+
+	return originMaxHTTPVersionValue(setting)
+}
+
+func originMaxHTTPVersionValue(setting ZoneSetting) (int, error) {
+	value, ok := setting.Value.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected value %v for zone setting %s", setting.Value, setting.ID)
+	}
+
+	return version, nil
+}
+
+// GetOriginErrorPagePassThru reports whether error pages returned by the
+// origin are passed through instead of being replaced by Cloudflare's own.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-enable-error-pages-on-setting
+func (api *API) GetOriginErrorPagePassThru(ctx context.Context, rc *ResourceContainer) (bool, error) {
+	return api.getZoneSettingToggle(ctx, rc, "origin_error_page_pass_thru")
+}
+
+// SetOriginErrorPagePassThru enables or disables passing through origin error
+// pages for the zone, returning the resulting state.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-enable-error-pages-on-setting
+func (api *API) SetOriginErrorPagePassThru(ctx context.Context, rc *ResourceContainer, on bool) (bool, error) {
+	return api.setZoneSettingToggle(ctx, rc, "origin_error_page_pass_thru", on)
+}
+
+// DiffZoneSettings compares the current zone settings with the desired ones
+// and returns only the settings that need to change, suitable for passing to
+// UpdateZoneSettings. Values are compared by their JSON representation so
+// that, for example, an int and the float64 decoded from a response are
+// considered equal.
+//
+// Desired settings that are not present in current are always included.
+// Changes to settings that are marked as not editable can't be applied, so
+// they are returned in notEditable instead of the patch so that callers can
+// tell them apart from settings that are already in sync.
+func DiffZoneSettings(current, desired []ZoneSetting) (patch, notEditable []ZoneSetting) {
+	existing := make(map[string]ZoneSetting, len(current))
+	for _, setting := range current {
+		existing[setting.ID] = setting
+	}
+
+	patch = []ZoneSetting{}
+	for _, setting := range desired {
+		c, ok := existing[setting.ID]
+		if ok && zoneSettingValuesEqual(c.Value, setting.Value) {
+			continue
+		}
+
+		if ok && !c.Editable {
+			notEditable = append(notEditable, ZoneSetting{ID: setting.ID, Value: setting.Value})
+			continue
+		}
+
+		patch = append(patch, ZoneSetting{ID: setting.ID, Value: setting.Value})
+	}
+
+	return patch, notEditable
+}
+
+// zoneSettingValuesEqual reports whether two setting values have the same
+// JSON representation.
+func zoneSettingValuesEqual(a, b interface{}) bool {
+	normalize := func(v interface{}) (interface{}, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var out interface{}
+		err = json.Unmarshal(b, &out)
+		return out, err
+	}
+
+	na, err := normalize(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(na, nb)
+}
+
 // ZoneExport returns the text BIND config for the given zone
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-export-dns-records
@@ -995,6 +2014,20 @@ func (api *API) ZoneExport(ctx context.Context, zoneID string) (string, error) {
 	return string(res), nil
 }
 
+// ZoneExportReader returns the text BIND config for the given zone as a
+// reader. When the client is configured with `WithResponseStreaming` the
+// export is streamed from the API instead of being held in memory, which is
+// preferable for large zones. The caller must close the returned reader.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-export-dns-records
+func (api *API) ZoneExportReader(ctx context.Context, zoneID string) (io.ReadCloser, error) {
+	if zoneID == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	return api.makeRequestStream(ctx, http.MethodGet, "/zones/"+zoneID+"/dns_records/export", nil)
+}
+
 // ZoneDNSSECResponse represents the response from the Zone DNSSEC Setting.
 type ZoneDNSSECResponse struct {
 	Response
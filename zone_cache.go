@@ -0,0 +1,94 @@
+package cloudflare
+
+import (
+	"sync"
+	"time"
+)
+
+// maxZoneCacheEntries bounds the number of zone names remembered by the zone
+// cache.
+const maxZoneCacheEntries = 1000
+
+type zoneCacheEntry struct {
+	id      string
+	expires time.Time
+}
+
+// zoneCache remembers the IDs of zones looked up by name.
+type zoneCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	max     int
+	entries map[string]zoneCacheEntry
+	now     func() time.Time
+}
+
+func newZoneCache(ttl time.Duration) *zoneCache {
+	return &zoneCache{
+		ttl:     ttl,
+		max:     maxZoneCacheEntries,
+		entries: make(map[string]zoneCacheEntry),
+		now:     time.Now,
+	}
+}
+
+func (c *zoneCache) get(name string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[name]
+	if !ok {
+		return "", false
+	}
+
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, name)
+		return "", false
+	}
+
+	return entry.id, true
+}
+
+func (c *zoneCache) set(name, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, ok := c.entries[name]; !ok && len(c.entries) >= c.max {
+		c.evict(now)
+	}
+
+	c.entries[name] = zoneCacheEntry{id: id, expires: now.Add(c.ttl)}
+}
+
+// evict removes the expired entries or, when none have expired, the entry
+// closest to expiring.
+func (c *zoneCache) evict(now time.Time) {
+	var oldest string
+	for name, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, name)
+			continue
+		}
+
+		if oldest == "" || entry.expires.Before(c.entries[oldest].expires) {
+			oldest = name
+		}
+	}
+
+	if len(c.entries) >= c.max {
+		delete(c.entries, oldest)
+	}
+}
+
+// invalidate forgets every name that resolved to the zone ID.
+func (c *zoneCache) invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, entry := range c.entries {
+		if entry.id == id {
+			delete(c.entries, name)
+		}
+	}
+}
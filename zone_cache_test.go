@@ -0,0 +1,85 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneIDByName_WithZoneCache(t *testing.T) {
+	setup(WithZoneCache(time.Minute))
+	defer teardown()
+
+	lookups := 0
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "example.com", r.URL.Query().Get("name"))
+		lookups++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "%s", "name": "example.com"}],
+			"result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+		}`, testZoneID)
+	})
+	mux.HandleFunc("/zones/"+testZoneID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testZoneID)
+	})
+
+	for i := 0; i < 3; i++ {
+		id, err := client.ZoneIDByName("example.com")
+		if assert.NoError(t, err) {
+			assert.Equal(t, testZoneID, id)
+		}
+	}
+	assert.Equal(t, 1, lookups)
+
+	_, err := client.DeleteZone(context.Background(), testZoneID)
+	assert.NoError(t, err)
+
+	_, err = client.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lookups)
+}
+
+func TestZoneCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newZoneCache(time.Minute)
+	cache.max = 2
+	cache.now = func() time.Time { return now }
+
+	cache.set("a.example.com", "a")
+	now = now.Add(time.Second)
+	cache.set("b.example.com", "b")
+	now = now.Add(time.Second)
+	cache.set("c.example.com", "c")
+
+	_, ok := cache.get("a.example.com")
+	assert.False(t, ok, "oldest entry should be evicted when full")
+
+	id, ok := cache.get("b.example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "b", id)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get("c.example.com")
+	assert.False(t, ok, "entry should expire after the TTL")
+
+	cache.set("d.example.com", "d")
+	cache.invalidate("d")
+	_, ok = cache.get("d.example.com")
+	assert.False(t, ok)
+}
+
+func TestWithZoneCache_InvalidTTL(t *testing.T) {
+	_, err := New("deadbeef", "cloudflare@example.org", WithZoneCache(0))
+	assert.Error(t, err)
+}
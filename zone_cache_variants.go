@@ -37,10 +37,16 @@ type zoneCacheVariantsSingleResponse struct {
 	Result ZoneCacheVariants `json:"result"`
 }
 
-// ZoneCacheVariants returns information about the current cache variants
+// ZoneCacheVariants returns information about the current cache variants,
+// the alternative image formats served based on the Accept header. Other
+// cache key customizations are made with UpdateCacheKeyRules.
 //
 // API reference: https://api.cloudflare.com/#zone-cache-settings-get-variants-setting
 func (api *API) ZoneCacheVariants(ctx context.Context, zoneID string) (ZoneCacheVariants, error) {
+	if zoneID == "" {
+		return ZoneCacheVariants{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/cache/variants", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -58,6 +64,10 @@ func (api *API) ZoneCacheVariants(ctx context.Context, zoneID string) (ZoneCache
 //
 // API reference: https://api.cloudflare.com/#zone-cache-settings-change-variants-setting
 func (api *API) UpdateZoneCacheVariants(ctx context.Context, zoneID string, variants ZoneCacheVariantsValues) (ZoneCacheVariants, error) {
+	if zoneID == "" {
+		return ZoneCacheVariants{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/cache/variants", zoneID)
 
 	updateReq := updateZoneCacheVariantsRequest{Value: variants}
@@ -79,6 +89,10 @@ func (api *API) UpdateZoneCacheVariants(ctx context.Context, zoneID string, vari
 //
 // API reference: https://api.cloudflare.com/#zone-cache-settings-delete-variants-setting
 func (api *API) DeleteZoneCacheVariants(ctx context.Context, zoneID string) error {
+	if zoneID == "" {
+		return ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/cache/variants", zoneID)
 	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -5,9 +5,11 @@ import (
 	"crypto/md5"   //nolint:gosec
 	"encoding/hex" // for generating IDs
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1019,6 +1021,103 @@ func TestCreateZonePartialSetup(t *testing.T) {
 	}
 }
 
+func TestCreateZones(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var body newZone
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "01a7362d577a6c3019a474fd6f485823", body.Account.ID)
+
+		w.Header().Set("content-type", "application/json")
+		if body.Name == "taken.example" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1061, "message": "taken.example already exists"}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%x", "name": %q}}`, body.Name, body.Name)
+	})
+
+	account := &Account{ID: "01a7362d577a6c3019a474fd6f485823"}
+	names := []string{"a.example", "b.example", "taken.example", "c.example", "d.example"}
+	params := make([]ZoneCreateParams, 0, len(names))
+	for _, name := range names {
+		params = append(params, ZoneCreateParams{Name: name, Account: account})
+	}
+
+	results := client.CreateZones(context.Background(), params, 2)
+	if assert.Len(t, results, len(names)) {
+		for i, result := range results {
+			assert.Equal(t, params[i], result.Params)
+			if names[i] == "taken.example" {
+				assert.False(t, result.Success)
+				var reqErr *RequestError
+				assert.ErrorAs(t, result.Err, &reqErr)
+				continue
+			}
+			assert.True(t, result.Success, names[i])
+			assert.NoError(t, result.Err)
+			assert.Equal(t, names[i], result.Zone.Name)
+		}
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = client.CreateZones(ctx, params, 0)
+	for _, result := range results {
+		assert.False(t, result.Success)
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
+
+func TestZoneActivationCheck(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/activation_check", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+"success": true,
+"errors": [],
+"messages": [],
+"result": {
+    "id": "%s",
+    "status": "pending"
+  }
+}`, testZoneID)
+	})
+
+	want := ZoneActivationCheckResult{
+		ID:     testZoneID,
+		Status: "pending",
+	}
+
+	actual, err := client.ZoneActivationCheck(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ZoneActivationCheck(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+}
+
 func TestFallbackOrigin_FallbackOrigin(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1610,3 +1709,555 @@ func TestUpdateZoneSettingWithCustomPathPrefix(t *testing.T) {
 		assert.Equal(t, s.ModifiedOn, "2014-01-01T05:20:00.12345Z")
 	}
 }
+
+func TestAlwaysOnline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	value := "off"
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": "on"}`, string(body))
+			value = "on"
+		} else {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{
+			"result": {
+				"id": "always_online",
+				"value": %q,
+				"editable": true,
+				"modified_on": "2014-01-01T05:20:00.12345Z"
+			}
+		}`, value)
+	}
+	mux.HandleFunc("/zones/foo/settings/always_online", handler)
+
+	on, err := client.GetAlwaysOnline(context.Background(), ZoneIdentifier("foo"))
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+
+	on, err = client.SetAlwaysOnline(context.Background(), ZoneIdentifier("foo"), true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestGetOfflineTempErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/offline_temp_errors", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"result": {"id": "offline_temp_errors", "value": "on", "editable": true}}`)
+	})
+
+	on, err := client.GetOfflineTempErrors(context.Background(), ZoneIdentifier("foo"))
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestGetAlwaysOnlineCrawlStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/always_online", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"archived_urls": ["https://example.com/", "https://example.com/about"],
+				"last_crawled_on": "2024-01-01T05:20:00Z"
+			}
+		}`)
+	})
+
+	lastCrawledOn := time.Date(2024, time.January, 1, 5, 20, 0, 0, time.UTC)
+	status, err := client.GetAlwaysOnlineCrawlStatus(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, AlwaysOnlineCrawlStatus{
+			ArchivedURLs:  []string{"https://example.com/", "https://example.com/about"},
+			LastCrawledOn: &lastCrawledOn,
+		}, status)
+	}
+
+	_, err = client.GetAlwaysOnlineCrawlStatus(context.Background(), AccountIdentifier(testAccountID))
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+}
+
+func TestZoneSettingToggles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, name := range []string{"http3", "0rtt", "early_hints", "fonts", "speed_brain", "origin_error_page_pass_thru", "broken"} {
+		name := name
+		mux.HandleFunc("/zones/foo/settings/"+name, func(w http.ResponseWriter, r *http.Request) {
+			value := "on"
+			if r.Method == http.MethodPatch {
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"value": "off"}`, string(body))
+				value = "off"
+			}
+			if name == "broken" {
+				value = "maybe"
+			}
+			w.Header().Set("content-type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"result": {"id": %q, "value": %q, "editable": true}}`, name, value)
+		})
+	}
+
+	rc := ZoneIdentifier("foo")
+	toggles := map[string]struct {
+		get func(context.Context, *ResourceContainer) (bool, error)
+		set func(context.Context, *ResourceContainer, bool) (bool, error)
+	}{
+		"http3":                       {client.GetHTTP3, client.SetHTTP3},
+		"0rtt":                        {client.Get0RTT, client.Set0RTT},
+		"early_hints":                 {client.GetEarlyHints, client.SetEarlyHints},
+		"fonts":                       {client.GetFonts, client.SetFonts},
+		"speed_brain":                 {client.GetSpeedBrain, client.SetSpeedBrain},
+		"origin_error_page_pass_thru": {client.GetOriginErrorPagePassThru, client.SetOriginErrorPagePassThru},
+	}
+
+	for name, toggle := range toggles {
+		on, err := toggle.get(context.Background(), rc)
+		if assert.NoError(t, err, name) {
+			assert.True(t, on, name)
+		}
+
+		on, err = toggle.set(context.Background(), rc, false)
+		if assert.NoError(t, err, name) {
+			assert.False(t, on, name)
+		}
+	}
+
+	_, err := client.getZoneSettingToggle(context.Background(), rc, "broken")
+	assert.EqualError(t, err, "unexpected value maybe for zone setting broken")
+}
+
+func TestSecurityLevelAndChallengeTTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/security_level", func(w http.ResponseWriter, r *http.Request) {
+		value := "medium"
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": "under_attack"}`, string(body))
+			value = "under_attack"
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "security_level", "value": %q, "editable": true}}`, value)
+	})
+	mux.HandleFunc("/zones/foo/settings/challenge_ttl", func(w http.ResponseWriter, r *http.Request) {
+		value := 1800
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": 300}`, string(body))
+			value = 300
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "challenge_ttl", "value": %d, "editable": true}}`, value)
+	})
+
+	rc := ZoneIdentifier("foo")
+
+	level, err := client.GetSecurityLevel(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSecurityLevelMedium, level)
+	}
+
+	_, err = client.SetSecurityLevel(context.Background(), rc, "help")
+	assert.ErrorIs(t, err, ErrInvalidZoneSecurityLevel)
+
+	level, err = client.SetSecurityLevel(context.Background(), rc, ZoneSecurityLevelUnderAttack)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSecurityLevelUnderAttack, level)
+	}
+
+	ttl, err := client.GetChallengeTTL(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1800, ttl)
+	}
+
+	_, err = client.SetChallengeTTL(context.Background(), rc, 60)
+	assert.ErrorIs(t, err, ErrInvalidChallengeTTL)
+
+	ttl, err = client.SetChallengeTTL(context.Background(), rc, 300)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 300, ttl)
+	}
+}
+
+func TestPolishAndMinify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/polish", func(w http.ResponseWriter, r *http.Request) {
+		value := "lossless"
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": "lossy"}`, string(body))
+			value = "lossy"
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "polish", "value": %q, "editable": true}}`, value)
+	})
+	mux.HandleFunc("/zones/foo/settings/minify", func(w http.ResponseWriter, r *http.Request) {
+		value := `{"html": "off", "css": "on", "js": "off"}`
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": {"html": "on", "css": "on", "js": "off"}}`, string(body))
+			value = `{"html": "on", "css": "on", "js": "off"}`
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "minify", "value": %s, "editable": true}}`, value)
+	})
+	mux.HandleFunc("/zones/foo/settings/rocket_loader", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprint(w, `{"result": {"id": "rocket_loader", "value": "on", "editable": true}}`)
+	})
+
+	rc := ZoneIdentifier("foo")
+
+	polish, err := client.GetPolish(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, PolishLossless, polish)
+	}
+
+	_, err = client.SetPolish(context.Background(), rc, Polish(0))
+	assert.ErrorIs(t, err, ErrInvalidPolish)
+
+	polish, err = client.SetPolish(context.Background(), rc, PolishLossy)
+	if assert.NoError(t, err) {
+		assert.Equal(t, PolishLossy, polish)
+	}
+
+	minify, err := client.GetMinify(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneMinify{CSS: true}, minify)
+	}
+
+	minify, err = client.SetMinify(context.Background(), rc, ZoneMinify{HTML: true, CSS: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneMinify{HTML: true, CSS: true}, minify)
+	}
+
+	on, err := client.GetRocketLoader(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSecurityHeaderAndNEL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/security_header", func(w http.ResponseWriter, r *http.Request) {
+		value := `{"strict_transport_security": {"enabled": false, "max_age": 0, "include_subdomains": false, "preload": false, "nosniff": false}}`
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			value = `{"strict_transport_security": {"enabled": true, "max_age": 31536000, "include_subdomains": true, "preload": true, "nosniff": true}}`
+			assert.JSONEq(t, `{"value": `+value+`}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "security_header", "value": %s, "editable": true}}`, value)
+	})
+	mux.HandleFunc("/zones/foo/settings/nel", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"value": {"enabled": true}}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprint(w, `{"result": {"id": "nel", "value": {"enabled": true}, "editable": true}}`)
+	})
+
+	rc := ZoneIdentifier("foo")
+
+	header, err := client.GetSecurityHeader(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, SecurityHeader{}, header)
+	}
+
+	_, err = client.UpdateSecurityHeader(context.Background(), rc, SecurityHeader{
+		StrictTransportSecurity: StrictTransportSecurity{Enabled: true, MaxAge: 86400, Preload: true},
+	})
+	assert.ErrorIs(t, err, ErrInvalidHSTSPreload)
+
+	want := SecurityHeader{
+		StrictTransportSecurity: StrictTransportSecurity{
+			Enabled:           true,
+			MaxAge:            31536000,
+			IncludeSubdomains: true,
+			Preload:           true,
+			Nosniff:           true,
+		},
+	}
+	header, err = client.UpdateSecurityHeader(context.Background(), rc, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, header)
+	}
+
+	on, err := client.SetNEL(context.Background(), rc, true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestAutomaticPlatformOptimization(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/automatic_platform_optimization", func(w http.ResponseWriter, r *http.Request) {
+		value := `{"enabled": false, "cf": true, "wordpress": false, "wp_plugin": false, "hostnames": [], "cache_by_device_type": false}`
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			value = `{"enabled": true, "cf": true, "wordpress": true, "wp_plugin": true, "hostnames": ["www.example.com"], "cache_by_device_type": true}`
+			assert.JSONEq(t, `{"value": `+value+`}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "automatic_platform_optimization", "value": %s, "editable": true}}`, value)
+	})
+
+	rc := ZoneIdentifier("foo")
+
+	apo, err := client.GetAutomaticPlatformOptimization(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, AutomaticPlatformOptimization{CF: true, Hostnames: []string{}}, apo)
+	}
+
+	_, err = client.SetAutomaticPlatformOptimization(context.Background(), rc, AutomaticPlatformOptimization{Enabled: true, Hostnames: []string{""}})
+	assert.ErrorIs(t, err, ErrInvalidAPOHostname)
+
+	_, err = client.SetAutomaticPlatformOptimization(context.Background(), rc, AutomaticPlatformOptimization{Enabled: true, WordPressPlugin: true})
+	assert.ErrorIs(t, err, ErrInvalidAPOWordPressPlugin)
+
+	want := AutomaticPlatformOptimization{
+		Enabled:           true,
+		CF:                true,
+		WordPress:         true,
+		WordPressPlugin:   true,
+		Hostnames:         []string{"www.example.com"},
+		CacheByDeviceType: true,
+	}
+	apo, err = client.SetAutomaticPlatformOptimization(context.Background(), rc, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, apo)
+	}
+}
+
+func TestOriginMaxHTTPVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/origin_max_http_version", func(w http.ResponseWriter, r *http.Request) {
+		value := "2"
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": "1"}`, string(body))
+			value = "1"
+		}
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"result": {"id": "origin_max_http_version", "value": %q, "editable": true}}`, value)
+	})
+
+	rc := ZoneIdentifier("foo")
+
+	version, err := client.GetOriginMaxHTTPVersion(context.Background(), rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, version)
+	}
+
+	_, err = client.SetOriginMaxHTTPVersion(context.Background(), rc, 3)
+	assert.ErrorIs(t, err, ErrInvalidOriginMaxHTTPVersion)
+
+	version, err = client.SetOriginMaxHTTPVersion(context.Background(), rc, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, version)
+	}
+}
+
+func TestDiffZoneSettings(t *testing.T) {
+	current := []ZoneSetting{
+		{ID: "ssl", Value: "full", Editable: true},
+		{ID: "min_tls_version", Value: "1.0", Editable: true},
+		{ID: "max_upload", Value: float64(100), Editable: true},
+		{ID: "minify", Value: map[string]interface{}{"css": "on", "js": "off"}, Editable: true},
+		{ID: "http2", Value: "on", Editable: false},
+		{ID: "ipv6", Value: "on", Editable: false},
+	}
+
+	desired := []ZoneSetting{
+		{ID: "ssl", Value: "full"},
+		{ID: "min_tls_version", Value: "1.2"},
+		{ID: "max_upload", Value: 100},
+		{ID: "minify", Value: map[string]string{"css": "on", "js": "off"}},
+		{ID: "http2", Value: "off"},
+		{ID: "ipv6", Value: "on"},
+		{ID: "always_use_https", Value: "on"},
+	}
+
+	want := []ZoneSetting{
+		{ID: "min_tls_version", Value: "1.2"},
+		{ID: "always_use_https", Value: "on"},
+	}
+
+	patch, notEditable := DiffZoneSettings(current, desired)
+	assert.Equal(t, want, patch)
+	assert.Equal(t, []ZoneSetting{{ID: "http2", Value: "off"}}, notEditable)
+
+	patch, notEditable = DiffZoneSettings(current, current)
+	assert.Equal(t, []ZoneSetting{}, patch)
+	assert.Empty(t, notEditable)
+}
+
+func TestZoneSettingWithMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/settings/ssl", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"result": {"id": "ssl", "value": "full", "editable": false, "modified_on": "2014-01-01T05:20:00.12345Z"}}`)
+	})
+	mux.HandleFunc("/zones/foo/settings/http3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"result": {"id": "http3", "value": "on", "editable": true}}`)
+	})
+
+	setting, err := client.ZoneSettingWithMeta(context.Background(), ZoneIdentifier("foo"), "ssl")
+	if assert.NoError(t, err) {
+		modifiedOn := time.Date(2014, time.January, 1, 5, 20, 0, 123450000, time.UTC)
+		assert.Equal(t, ZoneSettingMeta{ID: "ssl", Value: "full", Editable: false, ModifiedOn: &modifiedOn}, setting)
+	}
+
+	setting, err = client.ZoneSettingWithMeta(context.Background(), ZoneIdentifier("foo"), "http3")
+	if assert.NoError(t, err) {
+		assert.True(t, setting.Editable)
+		assert.Nil(t, setting.ModifiedOn)
+	}
+}
+
+func TestZoneExportReader(t *testing.T) {
+	for _, streaming := range []bool{false, true} {
+		setup(WithResponseStreaming(streaming))
+
+		mux.HandleFunc("/zones/"+testZoneID+"/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+			fmt.Fprint(w, "www.example.com. 1 IN A 192.0.2.1")
+		})
+		mux.HandleFunc("/zones/missing/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1001, "message": "zone not found"}], "messages": [], "result": null}`)
+		})
+
+		_, err := client.ZoneExportReader(context.Background(), "")
+		assert.ErrorIs(t, err, ErrMissingZoneID)
+
+		r, err := client.ZoneExportReader(context.Background(), testZoneID)
+		if assert.NoError(t, err) {
+			export, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, "www.example.com. 1 IN A 192.0.2.1", string(export))
+		}
+
+		_, err = client.ZoneExportReader(context.Background(), "missing")
+		var notFound *NotFoundError
+		assert.ErrorAs(t, err, &notFound)
+
+		teardown()
+	}
+}
+
+func TestDeleteZoneWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := false
+	mux.HandleFunc("/zones/"+testZoneID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		deleted = true
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testZoneID)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9", "hostname": "app.example.com"}],
+			"result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 120, "total_pages": 120}
+		}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/spectrum/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "ea95132c15732412d22c1476fa83f27a", "dns": {"type": "CNAME", "name": "ssh.example.com"}}
+			]
+		}`)
+	})
+
+	_, err := client.DeleteZoneWithOptions(context.Background(), "", ZoneDeleteOptions{})
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+
+	// All checks are made by default.
+	_, err = client.DeleteZoneWithOptions(context.Background(), testZoneID, ZoneDeleteOptions{})
+	assert.ErrorIs(t, err, ErrZoneHasDependents)
+	var dependentsErr *ZoneDependentsError
+	if assert.ErrorAs(t, err, &dependentsErr) {
+		assert.Equal(t, []ZoneDependent{
+			{Type: "custom_hostname", Count: 120},
+			{Type: "spectrum_application", ID: "ea95132c15732412d22c1476fa83f27a", Name: "ssh.example.com"},
+		}, dependentsErr.Dependents)
+		assert.Contains(t, dependentsErr.Error(), "120 custom_hostname, spectrum_application ssh.example.com")
+	}
+	assert.False(t, deleted)
+
+	_, err = client.DeleteZoneWithOptions(context.Background(), testZoneID, ZoneDeleteOptions{
+		SkipCustomHostnames: true,
+	})
+	if assert.ErrorAs(t, err, &dependentsErr) {
+		assert.Len(t, dependentsErr.Dependents, 1)
+	}
+	assert.False(t, deleted)
+
+	zone, err := client.DeleteZoneWithOptions(context.Background(), testZoneID, ZoneDeleteOptions{
+		SkipCustomHostnames:      true,
+		SkipSpectrumApplications: true,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneID{ID: testZoneID}, zone)
+	}
+	assert.True(t, deleted)
+
+	deleted = false
+	zone, err = client.DeleteZoneWithOptions(context.Background(), testZoneID, ZoneDeleteOptions{
+		Force: true,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneID{ID: testZoneID}, zone)
+	}
+	assert.True(t, deleted)
+}